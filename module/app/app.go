@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -19,6 +20,7 @@ import (
 
 	peggyparams "github.com/althea-net/peggy/module/app/params"
 	"github.com/althea-net/peggy/module/x/peggy"
+	peggyclient "github.com/althea-net/peggy/module/x/peggy/client"
 	"github.com/althea-net/peggy/module/x/peggy/keeper"
 	peggytypes "github.com/althea-net/peggy/module/x/peggy/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -116,6 +118,8 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			peggyclient.LogicCallProposalHandler,
+			peggyclient.ERC20DeploymentProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -327,27 +331,54 @@ func NewPeggyApp(
 		homePath,
 	)
 
-	app.stakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(
-			app.distrKeeper.Hooks(),
-			app.slashingKeeper.Hooks(),
-		),
-	)
-
+	// the IBC keeper only reads historical info and the unbonding time from the
+	// staking keeper, so it does not need the staking hooks set below
 	app.ibcKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
 		app.GetSubspace(ibchost.ModuleName),
-		app.stakingKeeper,
+		stakingKeeper,
 		scopedIBCKeeper,
 	)
 
+	app.transferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
+	)
+
+	app.peggyKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[peggytypes.StoreKey],
+		app.GetSubspace(peggytypes.ModuleName),
+		&stakingKeeper,
+		app.bankKeeper,
+		app.transferKeeper,
+	)
+	app.peggyKeeper.SetLogicCallHooks(distrtypes.ModuleName, peggy.NewCommunityPoolLogicCallHooks(app.peggyKeeper, app.distrKeeper))
+
+	// an upgrade plan named after the peggy consensus version migrates the peggy store to it
+	app.upgradeKeeper.SetUpgradeHandler(fmt.Sprintf("peggy-v%d", peggy.AppModule{}.ConsensusVersion()), func(ctx sdk.Context, _ upgradetypes.Plan) {
+		if err := keeper.NewMigrator(app.peggyKeeper).Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+
+	app.stakingKeeper = *stakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(
+			app.distrKeeper.Hooks(),
+			app.slashingKeeper.Hooks(),
+			app.peggyKeeper.Hooks(),
+		),
+	)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(peggytypes.RouterKey, peggy.NewProposalHandler(app.peggyKeeper, app.distrKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -359,15 +390,11 @@ func NewPeggyApp(
 		govRouter,
 	)
 
-	app.transferKeeper = ibctransferkeeper.NewKeeper(
-		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
-		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
-	)
 	transferModule := transfer.NewAppModule(app.transferKeeper)
 
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	// ICS-20 transfers can carry an instruction to send the tokens on to Ethereum
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, peggy.NewIBCMiddleware(transferModule, app.peggyKeeper))
 	app.ibcKeeper.SetRouter(ibcRouter)
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -378,14 +405,6 @@ func NewPeggyApp(
 	)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.peggyKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[peggytypes.StoreKey],
-		app.GetSubspace(peggytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-	)
-
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
 	app.mm = module.NewManager(
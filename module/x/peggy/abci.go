@@ -10,19 +10,25 @@ import (
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
-	slashing(ctx, k)
+	// a validator changing its ethereum address changes the bridge validator set
+	rotated := k.ApplyDelegateKeyRotations(ctx)
+	slashing(ctx, k, rotated)
 	attestationTally(ctx, k)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
+	// receipts of deposits credited and records of transfers executed longer than
+	// the retention period ago are dropped
+	k.PruneDepositReceipts(ctx)
+	k.PruneTransferRecords(ctx)
 }
 
-func slashing(ctx sdk.Context, k keeper.Keeper) {
+func slashing(ctx sdk.Context, k keeper.Keeper, forceValsetRequest bool) {
 	params := k.GetParams(ctx)
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 
 	// valsets are sorted so the most recent one is first
 	valsets := k.GetValsets(ctx)
-	if len(valsets) == 0 {
+	if len(valsets) == 0 || forceValsetRequest {
 		k.SetValsetRequest(ctx)
 	}
 
@@ -30,27 +36,18 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 		signedWithinWindow := uint64(ctx.BlockHeight()) > params.SignedValsetsWindow && uint64(ctx.BlockHeight())-params.SignedValsetsWindow > vs.Height
 		switch {
 		// #1 condition
-		// We look through the full bonded validator set (not just the active set, include unbonding validators)
-		// and we slash users who haven't signed a valset that is currentHeight - signedBlocksWindow old
+		// We look through the full bonded validator set (not just the active set) and the validators that
+		// began unbonding after the valset was created and we slash users who haven't signed a valset that
+		// is currentHeight - signedBlocksWindow old
 		case signedWithinWindow:
 
-			// first we need to see which validators in the active set
+			// first we need to see which validators liable for the valset
 			// haven't signed the valdiator set and slash them,
 			confirms := k.GetValsetConfirms(ctx, vs.Nonce)
-			for _, val := range currentBondedSet {
-				found := false
-				for _, conf := range confirms {
-					if conf.EthAddress == k.GetEthAddress(ctx, val.GetOperator()) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					cons, _ := val.GetConsAddr()
-					k.StakingKeeper.Slash(ctx, cons,
-						ctx.BlockHeight(), val.ConsensusPower(),
-						params.SlashFractionValset)
-					k.StakingKeeper.Jail(ctx, cons)
+			for _, liable := range k.GetLiableValidators(ctx, vs.Height) {
+				// the validator may have rotated its keys since the valset was created
+				if !k.HasSignedValset(ctx, liable.Validator.GetOperator(), vs, confirms) {
+					slashLiableValidator(ctx, k, liable, params.SlashFractionValset)
 				}
 			}
 
@@ -59,7 +56,7 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 
 		// on the latest validator set, check for change in power against
 		// current, and emit a new validator set if the change in power >5%
-		case i == 0:
+		case i == 0 && !forceValsetRequest:
 			if types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiff(vs.Members) > 0.05 {
 				k.SetValsetRequest(ctx)
 			}
@@ -67,27 +64,17 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	// #2 condition
-	// We look through the full bonded set (not just the active set, include unbonding validators)
-	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
+	// We look through the full bonded set (not just the active set) and the validators that began
+	// unbonding after the batch was created and we slash users who haven't signed a batch
+	// confirmation that is >15hrs in blocks old
 	batches := k.GetOutgoingTxBatches(ctx)
 	for _, batch := range batches {
 		signedWithinWindow := uint64(ctx.BlockHeight()) > params.SignedBatchesWindow && uint64(ctx.BlockHeight())-params.SignedBatchesWindow > batch.Block
 		if signedWithinWindow {
 			confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
-			for _, val := range currentBondedSet {
-				found := false
-				for _, conf := range confirms {
-					// TODO: double check this logic
-					confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
-					if k.GetOrchestratorValidator(ctx, confVal).Equals(val.GetOperator()) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					cons, _ := val.GetConsAddr()
-					k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(), params.SlashFractionBatch)
-					k.StakingKeeper.Jail(ctx, cons)
+			for _, liable := range k.GetLiableValidators(ctx, batch.Block) {
+				if !k.HasSignedBatch(ctx, liable.Validator.GetOperator(), batch, confirms) {
+					slashLiableValidator(ctx, k, liable, params.SlashFractionBatch)
 				}
 			}
 
@@ -97,6 +84,32 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	// #3 condition
+	// Logic calls are held to the same window and slash fraction as batches, they are not
+	// deleted once the window is over since they are only cleaned up on execution or timeout.
+	// Instead we remember up to which creation height the calls have been checked
+	if uint64(ctx.BlockHeight()) > params.SignedBatchesWindow {
+		lastChecked := k.GetLastSlashedLogicCallBlock(ctx)
+		checkUntil := uint64(ctx.BlockHeight()) - params.SignedBatchesWindow - 1
+		for _, call := range k.GetOutgoingLogicCalls(ctx) {
+			if call.Block <= lastChecked || call.Block > checkUntil {
+				continue
+			}
+			confirms := k.GetLogicConfirmByInvalidationIdAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)
+			for _, liable := range k.GetLiableValidators(ctx, call.Block) {
+				if !k.HasSignedLogicCall(ctx, liable.Validator.GetOperator(), call, confirms) {
+					slashLiableValidator(ctx, k, liable, params.SlashFractionBatch)
+				}
+			}
+		}
+		if checkUntil > lastChecked {
+			k.SetLastSlashedLogicCallBlock(ctx, checkUntil)
+		}
+	}
+
+	// validators that unbonded long enough ago are no longer liable for anything
+	k.PruneUnbondingValidators(ctx)
+
+	// #4 condition
 	// Oracle events MsgDepositClaim, MsgWithdrawClaim
 	attmap := k.GetAttestationMapping(ctx)
 	for _, atts := range attmap {
@@ -165,7 +178,7 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
-	// #4 condition (stretch goal)
+	// #5 condition (stretch goal)
 	// TODO: lost eth key or delegate key
 	// 1. submit a message signed by the priv key to the chain and it slashes the validator who delegated to that key
 	// return
@@ -174,6 +187,16 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	// TODO: prune claims, attestations
 }
 
+// slashLiableValidator slashes a validator that did not sign an object it is liable for
+// and jails it if it is not jailed already
+func slashLiableValidator(ctx sdk.Context, k keeper.Keeper, liable keeper.LiableValidator, fraction sdk.Dec) {
+	cons, _ := liable.Validator.GetConsAddr()
+	k.StakingKeeper.Slash(ctx, cons, liable.InfractionHeight, liable.Power, fraction)
+	if val := k.StakingKeeper.Validator(ctx, liable.Validator.GetOperator()); val != nil && !val.IsJailed() {
+		k.StakingKeeper.Jail(ctx, cons)
+	}
+}
+
 // Iterate over all attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
@@ -251,7 +274,13 @@ func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
 	calls := k.GetOutgoingLogicCalls(ctx)
 	for _, call := range calls {
 		if call.Timeout < ethereumHeight {
-			k.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+			// the refund is applied in a cache context so that a failed cancellation leaves no trace
+			xCtx, commit := ctx.CacheContext()
+			if err := k.CancelOutgoingLogicCall(xCtx, call.InvalidationId, call.InvalidationNonce); err != nil {
+				ctx.Logger().Error("logic call cancellation failed", "cause", err.Error(), "nonce", call.InvalidationNonce)
+				continue
+			}
+			commit()
 		}
 	}
 }
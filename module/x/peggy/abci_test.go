@@ -9,6 +9,7 @@ import (
 	"github.com/althea-net/peggy/module/x/peggy/keeper"
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestValsetSlashing(t *testing.T) {
@@ -86,6 +87,82 @@ func TestBatchSlashing(t *testing.T) {
 	require.Nil(t, batch)
 }
 
+func TestUnbondingValidatorSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.PeggyKeeper
+	params := pk.GetParams(ctx)
+	for i, val := range keeper.ValAddrs {
+		pk.SetOrchestratorValidator(ctx, val, keeper.AccAddrs[i])
+	}
+
+	// a batch and a logic call are created while all validators are bonded
+	created := uint64(100)
+	ctx = ctx.WithBlockHeight(int64(created))
+	batch := &types.OutgoingTxBatch{
+		BatchNonce:    1,
+		Transactions:  []*types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         created,
+	}
+	pk.StoreBatchUnsafe(ctx, batch)
+	call := &types.OutgoingLogicCall{
+		InvalidationId:    []byte("invalidation id"),
+		InvalidationNonce: 1,
+		Block:             created,
+	}
+	pk.SetOutogingLogicCall(ctx, call)
+	for i, orch := range keeper.AccAddrs {
+		if i == 0 {
+			// don't sign with first validator
+			continue
+		}
+		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+			EthSigner:     keeper.EthAddrs[i].String(),
+			Orchestrator:  orch.String(),
+		})
+		pk.SetLogicCallConfirm(ctx, orch, &types.MsgConfirmLogicCall{
+			InvalidationId:    call.InvalidationId,
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         keeper.EthAddrs[i].String(),
+			Orchestrator:      orch.String(),
+		})
+	}
+
+	// the first validator leaves the bonded set right after
+	ctx = ctx.WithBlockHeight(int64(created + 1))
+	input.StakingKeeper.Jail(ctx, sdk.ConsAddress(keeper.ConsPubKeys[0].Address()))
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsUnbonding())
+
+	// it still owes the signatures
+	res, err := pk.ValidatorObligations(sdk.WrapSDKContext(ctx), &types.QueryValidatorObligationsRequest{ValidatorAddress: keeper.ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Batches, 1)
+	require.Len(t, res.LogicCalls, 1)
+	res, err = pk.ValidatorObligations(sdk.WrapSDKContext(ctx), &types.QueryValidatorObligationsRequest{ValidatorAddress: keeper.ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Batches)
+	require.Empty(t, res.LogicCalls)
+
+	// and is slashed for both once the window is over
+	tokens := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	ctx = ctx.WithBlockHeight(int64(created + params.SignedBatchesWindow + 1))
+	EndBlocker(ctx, pk)
+	slashed := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens()
+	require.True(t, slashed.LT(tokens))
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).GetTokens().Equal(tokens))
+
+	// the logic call is only checked once
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).GetTokens().Equal(slashed))
+
+	// and the validator is no longer liable once all windows are over
+	require.Nil(t, pk.GetUnbondingValidator(ctx, keeper.ValAddrs[0]))
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	pk := input.PeggyKeeper
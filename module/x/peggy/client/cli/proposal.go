@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	flagTransfers = "transfers"
+	flagFees      = "fees"
+)
+
+func CmdSubmitLogicCallProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logic-call [logic-contract-address] [abi-json-file] [method] [ethereum-timeout-height] [args...]",
+		Short: "Submit a proposal to issue a logic call on Ethereum funded by the community pool",
+		Long: `Submit a proposal to issue a logic call on Ethereum funded by the community pool.
+The payload is the ABI encoded call of the method, with the arguments converted to the types
+the method declares in the ABI JSON file. Integers can be given in decimal or 0x prefixed hex,
+bytes as 0x prefixed hex. The call times out after the given Ethereum block height.`,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			abiJSON, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "abi json file")
+			}
+			payload, err := types.PackLogicCallPayload(string(abiJSON), args[2], args[4:])
+			if err != nil {
+				return sdkerrors.Wrap(err, "payload")
+			}
+			timeout, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "ethereum timeout height")
+			}
+
+			transfers, err := parseCoinsFlag(cmd, flagTransfers)
+			if err != nil {
+				return err
+			}
+			fees, err := parseCoinsFlag(cmd, flagFees)
+			if err != nil {
+				return err
+			}
+			deposit, err := parseCoinsFlag(cmd, govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewLogicCallProposal(title, description, args[0], payload, transfers, fees, timeout)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagTransfers, "", "coins of the community pool transferred to the logic contract")
+	cmd.Flags().String(flagFees, "", "coins of the community pool paid to the relayer")
+
+	return cmd
+}
+
+func CmdSubmitERC20DeploymentProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-deployment [denom] [name] [symbol] [decimals]",
+		Short: "Submit a proposal to approve the deployment of an ERC20 representing a Cosmos originated denom",
+		Long: `Submit a proposal to approve the deployment of an ERC20 representing a Cosmos originated denom.
+Once it passes the orchestrators deploy the ERC20 with the given name, symbol and decimals through
+the bridge contract, deployments with other attributes are not accepted for the denom.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			decimals, err := strconv.ParseUint(args[3], 10, 8)
+			if err != nil {
+				return sdkerrors.Wrap(err, "decimals")
+			}
+			deposit, err := parseCoinsFlag(cmd, govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewERC20DeploymentProposal(title, description, args[0], args[1], args[2], decimals)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func parseCoinsFlag(cmd *cobra.Command, flag string) (sdk.Coins, error) {
+	s, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(s)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, flag)
+	}
+	return coins, nil
+}
@@ -1,10 +1,15 @@
 package cli
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 )
 
@@ -20,8 +25,30 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetCurrentValset(),
 		CmdGetValsetRequest(),
 		CmdGetValsetConfirm(),
+		CmdGetValsetConfirms(),
+		CmdGetValsetRequests(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetOutgoingTXBatches(),
+		CmdGetBatchConfirms(),
+		CmdGetBatchFees(),
+		CmdGetOutgoingLogicCalls(),
+		CmdGetLogicConfirms(),
+		CmdGetPendingDelegateKeyRotations(),
+		CmdGetDelegateKeysByValidator(),
+		CmdGetDelegateKeysByOrchestrator(),
+		CmdGetDelegateKeysByEthAddress(),
+		CmdGetDelegateKeyNonce(),
+		CmdGetValidatorObligations(),
+		CmdGetTokenMetadata(),
+		CmdGetBridgeStatus(),
+		CmdGetTransferStatus(),
+		CmdGetDepositsByReceiver(),
+		CmdGetDepositsBySender(),
+		CmdGetFallbackAccount(),
+		CmdGetAttestations(),
+		CmdGetAttestationsByNonce(),
+		CmdGetAttestationVotes(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -145,6 +172,71 @@ func CmdGetValsetConfirm() *cobra.Command {
 	}
 }
 
+func CmdGetValsetConfirms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-confirms [nonce]",
+		Short: "Get all valset confirmations with a particular nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetConfirmsByNonceRequest{
+				Nonce:      nonce,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ValsetConfirmsByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "valset confirms")
+	return cmd
+}
+
+func CmdGetValsetRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-requests",
+		Short: "Get the requested valsets, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLastValsetRequestsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.LastValsetRequests(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "valset requests")
+	return cmd
+}
+
 func CmdGetPendingValsetRequest() *cobra.Command {
 	return &cobra.Command{
 		Use:   "pending-valset-request [bech32 validator address]",
@@ -190,3 +282,587 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 		},
 	}
 }
+
+func CmdGetOutgoingTXBatches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-batches",
+		Short: "Get the outgoing TX batches which have not been executed on Ethereum yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingTxBatchesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.OutgoingTxBatches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing batches")
+	return cmd
+}
+
+func CmdGetBatchConfirms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-confirms [nonce] [token contract]",
+		Short: "Get all confirmations of the outgoing TX batch with a particular nonce and token contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchConfirmsRequest{
+				Nonce:           nonce,
+				ContractAddress: args[1],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.BatchConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "batch confirms")
+	return cmd
+}
+
+func CmdGetBatchFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-fees",
+		Short: "Get the fees a batch of each token contract in the outgoing pool would pay",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBatchFeeRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BatchFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "batch fees")
+	return cmd
+}
+
+func CmdGetOutgoingLogicCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outgoing-logic-calls",
+		Short: "Get the outgoing logic calls which have not been executed on Ethereum yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOutgoingLogicCallsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.OutgoingLogicCalls(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "outgoing logic calls")
+	return cmd
+}
+
+func CmdGetLogicConfirms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logic-confirms [hex invalidation id] [invalidation nonce]",
+		Short: "Get all confirmations of the outgoing logic call with a particular invalidation id and nonce",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogicConfirmsRequest{
+				InvalidationId:    invalidationID,
+				InvalidationNonce: invalidationNonce,
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.LogicConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "logic confirms")
+	return cmd
+}
+
+func CmdGetPendingDelegateKeyRotations() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-key-rotations [bech32 validator address]",
+		Short: "Get the delegate key rotations which have not taken effect yet, optionally for a particular validator",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingDelegateKeyRotationsRequest{}
+			if len(args) == 1 {
+				req.Validator = args[0]
+			}
+
+			res, err := queryClient.PendingDelegateKeyRotations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetDelegateKeysByValidator() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-keys-by-validator [bech32 validator address]",
+		Short: "Get the orchestrator and eth address a validator delegated to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByValidatorRequest{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.DelegateKeysByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetDelegateKeyNonce() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-key-nonce [bech32 validator address]",
+		Short: "Get the nonce the eth key of the next delegate keys of a validator has to sign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeyNonceRequest{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.DelegateKeyNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetDelegateKeysByOrchestrator() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-keys-by-orchestrator [bech32 orchestrator address]",
+		Short: "Get the validator and eth address an orchestrator acts for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByOrchestratorRequest{
+				OrchestratorAddress: args[0],
+			}
+
+			res, err := queryClient.DelegateKeysByOrchestrator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetDelegateKeysByEthAddress() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-keys-by-eth-address [eth address]",
+		Short: "Get the validator and orchestrator an eth address signs for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegateKeysByEthAddressRequest{
+				EthAddress: args[0],
+			}
+
+			res, err := queryClient.DelegateKeysByEthAddress(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetValidatorObligations() *cobra.Command {
+	return &cobra.Command{
+		Use:   "obligations [bech32 validator address]",
+		Short: "Get the valsets, batches and logic calls a validator has to sign and has not signed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorObligationsRequest{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.ValidatorObligations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetTokenMetadata() *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-metadata [token contract]",
+		Short: "Get the denom an ERC20 is bridged to and its bank metadata",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenMetadataRequest{
+				TokenContract: args[0],
+			}
+
+			res, err := queryClient.TokenMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetBridgeStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Get the observed Ethereum progress, the pending txs and batches and the validators falling behind",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeStatusRequest{}
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+const (
+	flagStatus    = "status"
+	flagClaimType = "claim-type"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+)
+
+func CmdGetAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations",
+		Short: "Get the attestations of Ethereum events by event nonce",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryAttestationsRequest{
+				Pagination: pageReq,
+			}
+
+			status, _ := cmd.Flags().GetString(flagStatus)
+			switch status {
+			case "":
+			case "observed":
+				req.Observed = types.OBSERVATION_FILTER_OBSERVED
+			case "pending":
+				req.Observed = types.OBSERVATION_FILTER_PENDING
+			default:
+				return fmt.Errorf("unknown status %q, expected observed or pending", status)
+			}
+			if claimType, _ := cmd.Flags().GetString(flagClaimType); claimType != "" {
+				value, ok := types.ClaimType_value["CLAIM_TYPE_"+strings.ToUpper(claimType)]
+				if !ok {
+					return fmt.Errorf("unknown claim type %q", claimType)
+				}
+				req.ClaimType = types.ClaimType(value)
+			}
+			req.MinHeight, _ = cmd.Flags().GetUint64(flagMinHeight)
+			req.MaxHeight, _ = cmd.Flags().GetUint64(flagMaxHeight)
+
+			res, err := queryClient.Attestations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagStatus, "", "only attestations that are observed or pending")
+	cmd.Flags().String(flagClaimType, "", "only attestations of a claim type: deposit, withdraw, erc20_deployed, or logic_call_executed")
+	cmd.Flags().Uint64(flagMinHeight, 0, "only attestations created at or after this height")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "only attestations created at or before this height")
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+	return cmd
+}
+
+func CmdGetTransferStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-status [tx id]",
+		Short: "Get whether a transfer to Ethereum is in the pool, in a batch or executed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferStatusRequest{
+				TxId: txID,
+			}
+
+			res, err := queryClient.TransferStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetDepositsByReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits-by-receiver [cosmos receiver]",
+		Short: "Get the receipts of the deposits from Ethereum credited to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositReceiptsByReceiverRequest{
+				CosmosReceiver: args[0],
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.DepositReceiptsByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "deposits by receiver")
+	return cmd
+}
+
+func CmdGetDepositsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits-by-sender [ethereum sender]",
+		Short: "Get the receipts of the deposits from an Ethereum address credited on Cosmos",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDepositReceiptsBySenderRequest{
+				EthereumSender: args[0],
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.DepositReceiptsBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "deposits by sender")
+	return cmd
+}
+
+func CmdGetFallbackAccount() *cobra.Command {
+	return &cobra.Command{
+		Use:   "fallback-account [ethereum sender]",
+		Short: "Get the account deposits of an Ethereum address fall back to when they can not be forwarded, with its balance and sweep nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFallbackAccountRequest{
+				EthereumSender: args[0],
+			}
+
+			res, err := queryClient.FallbackAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetAttestationsByNonce() *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestations-by-nonce [event nonce]",
+		Short: "Get the attestations of every claim made for an event nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttestationsByNonceRequest{
+				EventNonce: nonce,
+			}
+
+			res, err := queryClient.AttestationsByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func CmdGetAttestationVotes() *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestation-votes [event nonce] [base64 claim hash]",
+		Short: "Get the voters of an attestation with their power and the share of the power needed to observe it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			// the claim hash as the attestation queries print it
+			claimHash, err := base64.StdEncoding.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttestationVotesRequest{
+				EventNonce: nonce,
+				ClaimHash:  claimHash,
+			}
+
+			res, err := queryClient.AttestationVotes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
@@ -30,6 +32,8 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSendToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdRotateDelegateKeys(),
+		CmdSweepFallbackAccount(),
 		GetUnsafeTestingCmd(),
 	}...)
 
@@ -47,6 +51,8 @@ func GetUnsafeTestingCmd() *cobra.Command {
 	testingTxCmd.AddCommand([]*cobra.Command{
 		CmdUnsafeETHPrivKey(),
 		CmdUnsafeETHAddr(),
+		CmdUnsafeSignDelegateKeys(),
+		CmdUnsafeSignFallbackSweep(),
 	}...)
 
 	return testingTxCmd
@@ -92,6 +98,62 @@ func CmdUnsafeETHAddr() *cobra.Command {
 	}
 }
 
+func CmdUnsafeSignDelegateKeys() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-delegate-keys [peggy-id] [validator-address] [nonce] [eth-private-key]",
+		Short: "Print the signature an ECDSA eth key gives to be used as the delegate key of a validator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			val, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator address")
+			}
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "nonce")
+			}
+			privateKey, err := ethCrypto.HexToECDSA(strings.TrimPrefix(args[3], "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "eth private key")
+			}
+			sig, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(args[0], val, nonce), privateKey)
+			if err != nil {
+				return err
+			}
+			println(hex.EncodeToString(sig))
+			return nil
+		},
+	}
+}
+
+func CmdUnsafeSignFallbackSweep() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-fallback-sweep [peggy-id] [receiver] [nonce] [eth-private-key]",
+		Short: "Print the signature an ECDSA eth key gives to sweep its fallback account to a receiver",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			receiver, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "receiver")
+			}
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "nonce")
+			}
+			privateKey, err := ethCrypto.HexToECDSA(strings.TrimPrefix(args[3], "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "eth private key")
+			}
+			sig, err := types.NewEthereumSignature(types.GetFallbackSweepSignHash(args[0], receiver, nonce), privateKey)
+			if err != nil {
+				return err
+			}
+			println(hex.EncodeToString(sig))
+			return nil
+		},
+	}
+}
+
 func CmdSendToEth() *cobra.Command {
 	return &cobra.Command{
 		Use:   "send-to-eth [eth-dest] [amount] [bridge-fee]",
@@ -161,9 +223,13 @@ func CmdRequestBatch() *cobra.Command {
 
 func CmdSetOrchestratorAddress() *cobra.Command {
 	return &cobra.Command{
-		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
+		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
-		Args:  cobra.ExactArgs(3),
+		Long: `Allows validators to delegate their voting responsibilities to a given key.
+The transaction has to be signed by both the validator and the orchestrator key, and the
+ethereum signature has to be made by the ethereum key over the validator address and the
+validator's current delegate key nonce, see 'unsafe_testing sign-delegate-keys'.`,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -173,6 +239,67 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 				Validator:    args[0],
 				Orchestrator: args[1],
 				EthAddress:   args[2],
+				EthSignature: args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func CmdRotateDelegateKeys() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
+		Short: "Schedules a switch of a validator's delegate keys after the rotation grace period.",
+		Long: `Schedules a switch of a validator's delegate keys after the rotation grace period.
+The previous keys remain valid for confirming objects created before the switch. The
+transaction has to be signed by both the validator and the new orchestrator key, and the
+ethereum signature has to be made by the new ethereum key over the validator address and
+the validator's current delegate key nonce, see 'unsafe_testing sign-delegate-keys'.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRotateDelegateKeys{
+				Validator:    args[0],
+				Orchestrator: args[1],
+				EthAddress:   args[2],
+				EthSignature: args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func CmdSweepFallbackAccount() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sweep-fallback-account [ethereum-sender] [receiver] [ethereum-signature]",
+		Short: "Moves the coins held by the fallback account of an Ethereum sender to a receiver.",
+		Long: `Moves the coins held by the fallback account of an Ethereum sender to a receiver.
+Deposits that can not be forwarded over IBC, and forwarded transfers that time out or are
+refunded, end up in the fallback account. The ethereum signature has to be made by the key of
+the ethereum sender over the peggy id, the receiver and the current sweep nonce of the
+account, see 'query peggy fallback-account' and 'unsafe_testing sign-fallback-sweep'.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgSweepFallbackAccount{
+				EthereumSender: args[0],
+				Receiver:       args[1],
+				EthSignature:   args[2],
+				Submitter:      cliCtx.GetFromAddress().String(),
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/client/cli"
+	"github.com/althea-net/peggy/module/x/peggy/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	// LogicCallProposalHandler is the logic call proposal handler.
+	LogicCallProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitLogicCallProposal, rest.LogicCallProposalRESTHandler)
+	// ERC20DeploymentProposalHandler is the ERC20 deployment proposal handler.
+	ERC20DeploymentProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitERC20DeploymentProposal, rest.ERC20DeploymentProposalRESTHandler)
+)
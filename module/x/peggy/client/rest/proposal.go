@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type logicCallProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title                string         `json:"title" yaml:"title"`
+	Description          string         `json:"description" yaml:"description"`
+	LogicContractAddress string         `json:"logic_contract_address" yaml:"logic_contract_address"`
+	Payload              []byte         `json:"payload" yaml:"payload"`
+	Transfers            sdk.Coins      `json:"transfers" yaml:"transfers"`
+	Fees                 sdk.Coins      `json:"fees" yaml:"fees"`
+	Timeout              uint64         `json:"timeout" yaml:"timeout"`
+	Proposer             sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit              sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+type erc20DeploymentProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Denom       string         `json:"denom" yaml:"denom"`
+	Name        string         `json:"name" yaml:"name"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	Decimals    uint64         `json:"decimals" yaml:"decimals"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// LogicCallProposalRESTHandler returns the REST handler submitting logic call proposals
+func LogicCallProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "peggy_logic_call",
+		Handler:  postLogicCallProposalHandler(cliCtx),
+	}
+}
+
+func postLogicCallProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req logicCallProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewLogicCallProposal(req.Title, req.Description, req.LogicContractAddress, req.Payload, req.Transfers, req.Fees, req.Timeout)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// ERC20DeploymentProposalRESTHandler returns the REST handler submitting ERC20 deployment proposals
+func ERC20DeploymentProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "peggy_erc20_deployment",
+		Handler:  postERC20DeploymentProposalHandler(cliCtx),
+	}
+}
+
+func postERC20DeploymentProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req erc20DeploymentProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewERC20DeploymentProposal(req.Title, req.Description, req.Denom, req.Name, req.Symbol, req.Decimals)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
@@ -183,3 +183,23 @@ func currentValsetHandler(cliCtx client.Context, storeName string) http.HandlerF
 		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
 	}
 }
+
+// delegateKeysHandler resolves the address in the given path variable to the delegate keys it belongs to
+func delegateKeysHandler(cliCtx client.Context, storeName string, queryPath string, addrVar string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		addr := vars[addrVar]
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, queryPath, addr))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "delegate keys not found")
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+	}
+}
@@ -14,6 +14,8 @@ const (
 	bech32ValidatorAddress = "bech32ValidatorAddress"
 	claimType              = "claimType"
 	signType               = "signType"
+	bech32Address          = "bech32Address"
+	ethAddress             = "ethAddress"
 )
 
 // Here are the routes that are actually queried by the rust
@@ -57,4 +59,12 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router, storeName string) {
 	// This endpoint gets all of the batch confirmations for a given nonce and denom In order to determine if a batch is complete
 	// the relayer will compare the valset power on the contract to the number of signatures
 	r.HandleFunc(fmt.Sprintf("/%s/batch_confirm/{%s}/{%s}", storeName, nonce, tokenAddress), allBatchConfirmsHandler(cliCtx, storeName)).Methods("GET")
+
+	/// Delegate keys
+
+	// Resolves a validator, orchestrator or eth address to the delegate keys it belongs to, used by
+	// the orchestrator on startup to check that its keys are registered to the expected validator
+	r.HandleFunc(fmt.Sprintf("/%s/delegate_keys/validator/{%s}", storeName, bech32ValidatorAddress), delegateKeysHandler(cliCtx, storeName, "delegateKeysByValidator", bech32ValidatorAddress)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/delegate_keys/orchestrator/{%s}", storeName, bech32Address), delegateKeysHandler(cliCtx, storeName, "delegateKeysByOrchestrator", bech32Address)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/delegate_keys/eth/{%s}", storeName, ethAddress), delegateKeysHandler(cliCtx, storeName, "delegateKeysByEthAddress", ethAddress)).Methods("GET")
 }
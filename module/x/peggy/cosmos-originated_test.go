@@ -6,7 +6,9 @@ import (
 	"github.com/althea-net/peggy/module/x/peggy/keeper"
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -43,7 +45,7 @@ func initializeTestingVars(t *testing.T) *testingVars {
 	tv.myOrchestratorAddr = make([]byte, sdk.AddrLen)
 	tv.myValAddr = sdk.ValAddress(tv.myOrchestratorAddr) // revisit when proper mapping is impl in keeper
 
-	tv.erc20 = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+	tv.erc20 = "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
 	tv.denom = "uatom"
 
 	tv.input = keeper.CreateTestEnv(t)
@@ -71,6 +73,12 @@ func addDenomToERC20Relation(tv *testingVars) {
 		myNonce = uint64(1)
 	)
 
+	// governance approves the deployment
+	p := types.NewERC20DeploymentProposal("deploy atom", "deploy an ERC20 for atom", tv.denom, "atom", "atom", 6)
+	require.NoError(tv.t, p.ValidateBasic())
+	require.NoError(tv.t, NewProposalHandler(tv.input.PeggyKeeper, tv.input.DistKeeper)(tv.ctx, p))
+	require.NotNil(tv.t, tv.input.PeggyKeeper.GetERC20DeploymentRequest(tv.ctx, tv.denom))
+
 	ethClaim := types.MsgERC20DeployedClaim{
 		CosmosDenom:   tv.denom,
 		TokenContract: tv.erc20,
@@ -100,6 +108,183 @@ func addDenomToERC20Relation(tv *testingVars) {
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
 	assert.Equal(tv.t, tv.erc20, gotERC20)
+
+	// the approval is used up
+	assert.Nil(tv.t, tv.input.PeggyKeeper.GetERC20DeploymentRequest(tv.ctx, tv.denom))
+}
+
+func TestERC20DeployedClaimRequiresApproval(t *testing.T) {
+	tv := initializeTestingVars(t)
+	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, bank.Metadata{
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: "uatom", Exponent: uint32(0)},
+			{Denom: "atom", Exponent: uint32(6)},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	})
+	h := NewProposalHandler(tv.input.PeggyKeeper, tv.input.DistKeeper)
+
+	// governance can only approve deployments matching the denom metadata
+	assert.Error(t, h(tv.ctx, types.NewERC20DeploymentProposal("t", "d", tv.denom, "atom", "atom", 18)))
+	assert.Error(t, h(tv.ctx, types.NewERC20DeploymentProposal("t", "d", "unknown", "atom", "atom", 6)))
+
+	deploy := func(nonce uint64, decimals uint64) {
+		_, err := tv.h(tv.ctx, &types.MsgERC20DeployedClaim{
+			CosmosDenom:   tv.denom,
+			TokenContract: tv.erc20,
+			Name:          "atom",
+			Symbol:        "atom",
+			Decimals:      decimals,
+			EventNonce:    nonce,
+			Orchestrator:  tv.myOrchestratorAddr.String(),
+		})
+		require.NoError(t, err)
+		EndBlocker(tv.ctx, tv.input.PeggyKeeper)
+	}
+
+	// without an approval the observed claim is rejected
+	deploy(1, 6)
+	_, exists := tv.input.PeggyKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.False(t, exists)
+
+	// so is one not matching the approval
+	require.NoError(t, h(tv.ctx, types.NewERC20DeploymentProposal("t", "d", tv.denom, "atom", "atom", 6)))
+	deploy(2, 18)
+	_, exists = tv.input.PeggyKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.False(t, exists)
+
+	deploy(3, 6)
+	erc20, exists := tv.input.PeggyKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.True(t, exists)
+	assert.Equal(t, tv.erc20, erc20)
+
+	// and no second deployment can be approved
+	assert.Error(t, h(tv.ctx, types.NewERC20DeploymentProposal("t", "d", tv.denom, "atom", "atom", 6)))
+}
+
+func TestERC20DeploymentOfIBCDenom(t *testing.T) {
+	tv := initializeTestingVars(t)
+	tv.denom = tv.input.IBCKeeper.SetDenomTrace(ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"})
+	h := NewProposalHandler(tv.input.PeggyKeeper, tv.input.DistKeeper)
+
+	// vouchers of unknown traces can not be deployed
+	unknown := ibctransfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"}.IBCDenom()
+	assert.Error(t, h(tv.ctx, types.NewERC20DeploymentProposal("t", "d", unknown, "atom", "atom", 6)))
+
+	// without bank metadata the approved name, symbol and decimals are used
+	require.NoError(t, h(tv.ctx, types.NewERC20DeploymentProposal("t", "d", tv.denom, "atom", "atom", 6)))
+	var found bool
+	for _, e := range tv.ctx.EventManager().Events() {
+		if e.Type != types.EventTypeERC20DeploymentRequest {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == types.AttributeKeyIBCBaseDenom {
+				assert.Equal(t, "uatom", string(attr.Value))
+				found = true
+			}
+		}
+	}
+	assert.True(t, found)
+
+	_, err := tv.h(tv.ctx, &types.MsgERC20DeployedClaim{
+		CosmosDenom:   tv.denom,
+		TokenContract: tv.erc20,
+		Name:          "atom",
+		Symbol:        "atom",
+		Decimals:      6,
+		EventNonce:    1,
+		Orchestrator:  tv.myOrchestratorAddr.String(),
+	})
+	require.NoError(t, err)
+	EndBlocker(tv.ctx, tv.input.PeggyKeeper)
+
+	erc20, exists := tv.input.PeggyKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	assert.True(t, exists)
+	assert.Equal(t, tv.erc20, erc20)
+}
+
+func TestERC20MetadataClaim(t *testing.T) {
+	tv := initializeTestingVars(t)
+	addDenomToERC20Relation(tv)
+	const tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	voucherDenom := types.PeggyDenom(tokenContract)
+
+	_, err := tv.h(tv.ctx, &types.MsgERC20MetadataClaim{
+		TokenContract: tokenContract,
+		Name:          "Dai Stablecoin",
+		Symbol:        "DAI",
+		Decimals:      18,
+		Orchestrator:  tv.myOrchestratorAddr.String(),
+	})
+	require.NoError(t, err)
+	EndBlocker(tv.ctx, tv.input.PeggyKeeper)
+
+	expected := bank.Metadata{
+		Description: "Dai Stablecoin",
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: voucherDenom, Exponent: 0},
+			{Denom: "DAI", Exponent: 18},
+		},
+		Base:    voucherDenom,
+		Display: "DAI",
+	}
+	assert.Equal(t, expected, tv.input.BankKeeper.GetDenomMetaData(tv.ctx, voucherDenom))
+
+	res, err := tv.input.PeggyKeeper.TokenMetadata(sdk.WrapSDKContext(tv.ctx), &types.QueryTokenMetadataRequest{TokenContract: tokenContract})
+	require.NoError(t, err)
+	assert.Equal(t, voucherDenom, res.Denom)
+	assert.Equal(t, expected, res.Metadata)
+	require.Len(t, res.Attestations, 1)
+	assert.True(t, res.Attestations[0].Observed)
+
+	// the metadata of Cosmos originated denoms is not taken from their ERC20
+	before := tv.input.BankKeeper.GetDenomMetaData(tv.ctx, tv.denom)
+	_, err = tv.h(tv.ctx, &types.MsgERC20MetadataClaim{
+		TokenContract: tv.erc20,
+		Name:          "fake",
+		Symbol:        "fake",
+		Decimals:      0,
+		Orchestrator:  tv.myOrchestratorAddr.String(),
+	})
+	require.NoError(t, err)
+	EndBlocker(tv.ctx, tv.input.PeggyKeeper)
+	assert.Equal(t, before, tv.input.BankKeeper.GetDenomMetaData(tv.ctx, tv.denom))
+}
+
+func TestERC20MetadataConflictingSymbol(t *testing.T) {
+	tv := initializeTestingVars(t)
+	addDenomToERC20Relation(tv)
+	k := tv.input.PeggyKeeper
+	const (
+		daiContract  = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		fakeContract = "0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"
+	)
+	require.NoError(t, k.SetERC20Metadata(tv.ctx, daiContract, "Dai Stablecoin", "DAI", 18))
+
+	specs := map[string]struct {
+		symbol string
+		expErr *sdkerrors.Error
+	}{
+		"invalid denom":            {symbol: "1DAI", expErr: types.ErrInvalid},
+		"denom with supply":        {symbol: "stake", expErr: types.ErrDuplicate},
+		"unit of cosmos denom":     {symbol: "atom", expErr: types.ErrDuplicate},
+		"alias of cosmos denom":    {symbol: "microatom", expErr: types.ErrDuplicate},
+		"display of other voucher": {symbol: "DAI", expErr: types.ErrDuplicate},
+		"other voucher":            {symbol: types.PeggyDenom(daiContract), expErr: types.ErrDuplicate},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := k.SetERC20Metadata(tv.ctx, fakeContract, "fake", spec.symbol, 18)
+			assert.True(t, spec.expErr.Is(err), err)
+			assert.Empty(t, tv.input.BankKeeper.GetDenomMetaData(tv.ctx, types.PeggyDenom(fakeContract)).Base)
+		})
+	}
+
+	// a voucher can keep its own symbol when its metadata is observed again
+	require.NoError(t, k.SetERC20Metadata(tv.ctx, daiContract, "Dai", "DAI", 18))
+	assert.Equal(t, "Dai", tv.input.BankKeeper.GetDenomMetaData(tv.ctx, types.PeggyDenom(daiContract)).Description)
 }
 
 func lockCoinsInModule(tv *testingVars) {
@@ -186,3 +371,37 @@ func acceptDepositEvent(tv *testingVars) {
 		tv.input.BankKeeper.GetAllBalances(tv.ctx, peggyAddr),
 	)
 }
+
+func TestERC20DeploymentOfIBCDenomValidation(t *testing.T) {
+	tv := initializeTestingVars(t)
+	tv.denom = tv.input.IBCKeeper.SetDenomTrace(ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"})
+	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, bank.Metadata{
+		DenomUnits: []*bank.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "foo", Exponent: 6, Aliases: []string{"foocoin"}}},
+		Base:       "ufoo",
+		Display:    "foo",
+	})
+	k := tv.input.PeggyKeeper
+
+	specs := map[string]struct {
+		name, symbol string
+		decimals     uint64
+		expErr       *sdkerrors.Error
+	}{
+		"empty name":           {name: " ", symbol: "atom", decimals: 6, expErr: types.ErrEmpty},
+		"invalid symbol":       {name: "atom", symbol: "1atom", decimals: 6, expErr: types.ErrInvalid},
+		"too many decimals":    {name: "atom", symbol: "atom", decimals: 256, expErr: types.ErrInvalid},
+		"symbol with supply":   {name: "atom", symbol: "stake", decimals: 6, expErr: types.ErrDuplicate},
+		"symbol of metadata":   {name: "atom", symbol: "foo", decimals: 6, expErr: types.ErrDuplicate},
+		"symbol of unit alias": {name: "atom", symbol: "foocoin", decimals: 6, expErr: types.ErrDuplicate},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := k.ApproveERC20Deployment(tv.ctx, &types.ERC20DeploymentRequest{Denom: tv.denom, Name: spec.name, Symbol: spec.symbol, Decimals: spec.decimals})
+			assert.True(t, spec.expErr.Is(err), err)
+			assert.Nil(t, k.GetERC20DeploymentRequest(tv.ctx, tv.denom))
+		})
+	}
+
+	// the approved values are used once they are valid
+	require.NoError(t, k.ApproveERC20Deployment(tv.ctx, &types.ERC20DeploymentRequest{Denom: tv.denom, Name: "atom", Symbol: "atom", Decimals: 6}))
+}
@@ -19,6 +19,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSetOrchestratorAddress:
 			res, err := msgServer.SetOrchestratorAddress(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRotateDelegateKeys:
+			res, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSweepFallbackAccount:
+			res, err := msgServer.SweepFallbackAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgValsetConfirm:
 			res, err := msgServer.ValsetConfirm(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -40,6 +46,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgERC20DeployedClaim:
 			res, err := msgServer.ERC20DeployedClaim(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgERC20MetadataClaim:
+			res, err := msgServer.ERC20MetadataClaim(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Unrecognized Peggy Msg type: %v", msg.Type()))
 		}
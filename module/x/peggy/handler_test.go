@@ -2,12 +2,17 @@ package peggy
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	"github.com/althea-net/peggy/module/x/peggy/keeper"
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -117,7 +122,7 @@ func TestMsgDepositClaimSingleValidator(t *testing.T) {
 	require.NotNil(t, a)
 	// and vouchers added to the account
 	balance := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", amountA)}, balance)
 
 	// Test to reject duplicate deposit
 	// when
@@ -127,7 +132,7 @@ func TestMsgDepositClaimSingleValidator(t *testing.T) {
 	// then
 	require.Error(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", amountA)}, balance)
 
 	// Test to reject skipped nonce
 	ethClaim = types.MsgDepositClaim{
@@ -146,7 +151,7 @@ func TestMsgDepositClaimSingleValidator(t *testing.T) {
 	// then
 	require.Error(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountA)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", amountA)}, balance)
 
 	// Test to finally accept consecutive nonce
 	ethClaim = types.MsgDepositClaim{
@@ -166,7 +171,198 @@ func TestMsgDepositClaimSingleValidator(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	balance = input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", amountB)}, balance)
+	assert.Equal(t, sdk.Coins{sdk.NewCoin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", amountB)}, balance)
+}
+
+func TestMsgDepositClaimForwarding(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr) // revisit when proper mapping is impl in keeper
+		senderKey, _                      = ethCrypto.GenerateKey()
+		anyETHAddr                        = ethCrypto.PubkeyToAddress(senderKey.PublicKey).Hex()
+		tokenETHAddr                      = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		voucher                           = sdk.NewCoin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", sdk.NewInt(12))
+		fallbackAddr                      = types.EthereumSenderAccount(anyETHAddr)
+		escrowAddr                        = ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	)
+	remoteReceiver, err := bech32.ConvertAndEncode("osmo", bytes.Repeat([]byte{1}, sdk.AddrLen))
+	require.NoError(t, err)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	input.PeggyKeeper.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	input.PeggyKeeper.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(input.PeggyKeeper)
+
+	deposit := func(nonce uint64) {
+		_, err := h(ctx, &types.MsgDepositClaim{
+			EventNonce:     nonce,
+			TokenContract:  tokenETHAddr,
+			Amount:         voucher.Amount,
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: "channel-0/" + remoteReceiver,
+			Orchestrator:   myOrchestratorAddr.String(),
+		})
+		require.NoError(t, err)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		EndBlocker(ctx, input.PeggyKeeper)
+	}
+
+	// when
+	deposit(1)
+
+	// then the vouchers are transferred over IBC
+	require.Len(t, input.IBCKeeper.Transfers, 1)
+	assert.Equal(t, remoteReceiver, input.IBCKeeper.Transfers[0].Receiver)
+	assert.Equal(t, fallbackAddr.String(), input.IBCKeeper.Transfers[0].Sender)
+	assert.Equal(t, sdk.Coins{voucher}, input.BankKeeper.GetAllBalances(ctx, escrowAddr))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, fallbackAddr).IsZero())
+
+	// and the events of the transfer reach relayers
+	var relayed bool
+	for _, e := range ctx.EventManager().Events() {
+		relayed = relayed || e.Type == ibctransfertypes.EventTypeTransfer
+	}
+	assert.True(t, relayed)
+
+	// when the transfer times out it is refunded to the account of the Ethereum sender
+	require.NoError(t, input.IBCKeeper.Refund(ctx, "channel-0", 0))
+	assert.Equal(t, sdk.Coins{voucher}, input.BankKeeper.GetAllBalances(ctx, fallbackAddr))
+
+	// when the transfer can not be sent
+	input.IBCKeeper.SendTransferErr = ibctransfertypes.ErrSendDisabled
+	deposit(2)
+
+	// then the vouchers stay with the account of the Ethereum sender
+	assert.Len(t, input.IBCKeeper.Transfers, 1)
+	held := sdk.Coins{voucher.Add(voucher)}
+	assert.Equal(t, held, input.BankKeeper.GetAllBalances(ctx, fallbackAddr))
+
+	// which the Ethereum key can sweep to an account of its choice
+	receiver := sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	sign := func(receiver sdk.AccAddress, nonce uint64) string {
+		sig, err := types.NewEthereumSignature(types.GetFallbackSweepSignHash(input.PeggyKeeper.GetPeggyID(ctx), receiver, nonce), senderKey)
+		require.NoError(t, err)
+		return hex.EncodeToString(sig)
+	}
+	_, err = h(ctx, types.NewMsgSweepFallbackAccount(anyETHAddr, receiver, sign(myOrchestratorAddr, 0), myOrchestratorAddr))
+	assert.Error(t, err, "signed for another receiver")
+	sweep := types.NewMsgSweepFallbackAccount(anyETHAddr, receiver, sign(receiver, 0), myOrchestratorAddr)
+	_, err = h(ctx, sweep)
+	require.NoError(t, err)
+	assert.Equal(t, held, input.BankKeeper.GetAllBalances(ctx, receiver))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, fallbackAddr).IsZero())
+
+	// and the signature can not be replayed
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, receiver, fallbackAddr, sdk.Coins{voucher}))
+	_, err = h(ctx, sweep)
+	assert.Error(t, err)
+	res, err := input.PeggyKeeper.FallbackAccount(sdk.WrapSDKContext(ctx), &types.QueryFallbackAccountRequest{EthereumSender: anyETHAddr})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryFallbackAccountResponse{Account: fallbackAddr.String(), Balance: sdk.Coins{voucher}, SweepNonce: 1}, res)
+
+	// and malformed forwarding receivers are rejected
+	for _, receiver := range []string{"channel-0/", "ch/" + remoteReceiver, "channel-0/notbech32"} {
+		msg := types.MsgDepositClaim{
+			EventNonce:     3,
+			TokenContract:  tokenETHAddr,
+			Amount:         voucher.Amount,
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: receiver,
+			Orchestrator:   myOrchestratorAddr.String(),
+		}
+		assert.Error(t, msg.ValidateBasic(), receiver)
+	}
+}
+
+func TestMsgDepositClaimReceipts(t *testing.T) {
+	var (
+		myOrchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		myCosmosAddr, _                   = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		myValAddr                         = sdk.ValAddress(myOrchestratorAddr) // revisit when proper mapping is impl in keeper
+		anyETHAddr                        = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr                      = "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(10)
+	input.PeggyKeeper.StakingKeeper = keeper.NewStakingKeeperMock(myValAddr)
+	input.PeggyKeeper.SetOrchestratorValidator(ctx, myValAddr, myOrchestratorAddr)
+	h := NewHandler(input.PeggyKeeper)
+
+	ethClaim := types.MsgDepositClaim{
+		EventNonce:     1,
+		BlockHeight:    1234,
+		TokenContract:  tokenETHAddr,
+		Amount:         sdk.NewInt(12),
+		EthereumSender: anyETHAddr,
+		CosmosReceiver: myCosmosAddr.String(),
+		Orchestrator:   myOrchestratorAddr.String(),
+	}
+
+	// when
+	_, err := h(ctx, &ethClaim)
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, input.PeggyKeeper)
+
+	// then a typed event is emitted
+	attrs := make(map[string]string)
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != types.EventTypeBridgeDepositReceived {
+			continue
+		}
+		require.Empty(t, attrs, "one deposit event")
+		for _, a := range e.Attributes {
+			attrs[string(a.Key)] = string(a.Value)
+		}
+	}
+	assert.Equal(t, map[string]string{
+		sdk.AttributeKeyModule:           types.ModuleName,
+		types.AttributeKeyNonce:          "1",
+		types.AttributeKeyEthereumSender: anyETHAddr,
+		types.AttributeKeyCosmosReceiver: myCosmosAddr.String(),
+		types.AttributeKeyERC20Token:     tokenETHAddr,
+		types.AttributeKeyCosmosDenom:    types.PeggyDenom(tokenETHAddr),
+		sdk.AttributeKeyAmount:           "12",
+		types.AttributeKeyEthereumHeight: "1234",
+	}, attrs)
+
+	// and the receipt is found by receiver and by sender
+	expReceipt := types.DepositReceipt{
+		EventNonce:     1,
+		EthereumHeight: 1234,
+		EthereumSender: anyETHAddr,
+		CosmosReceiver: myCosmosAddr.String(),
+		TokenContract:  tokenETHAddr,
+		Denom:          types.PeggyDenom(tokenETHAddr),
+		Amount:         sdk.NewInt(12),
+		Height:         10,
+	}
+	byReceiver, err := input.PeggyKeeper.DepositReceiptsByReceiver(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsByReceiverRequest{CosmosReceiver: myCosmosAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []types.DepositReceipt{expReceipt}, byReceiver.Receipts)
+	bySender, err := input.PeggyKeeper.DepositReceiptsBySender(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsBySenderRequest{EthereumSender: anyETHAddr})
+	require.NoError(t, err)
+	assert.Equal(t, []types.DepositReceipt{expReceipt}, bySender.Receipts)
+	other, err := input.PeggyKeeper.DepositReceiptsBySender(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsBySenderRequest{EthereumSender: tokenETHAddr})
+	require.NoError(t, err)
+	assert.Empty(t, other.Receipts)
+
+	// receipts are kept for the retention period
+	retention := input.PeggyKeeper.GetParams(ctx).DepositReceiptRetention
+	ctx = ctx.WithBlockHeight(int64(10 + retention))
+	input.PeggyKeeper.PruneDepositReceipts(ctx)
+	assert.NotNil(t, input.PeggyKeeper.GetDepositReceipt(ctx, 1))
+
+	ctx = ctx.WithBlockHeight(int64(11 + retention))
+	input.PeggyKeeper.PruneDepositReceipts(ctx)
+	assert.Nil(t, input.PeggyKeeper.GetDepositReceipt(ctx, 1))
+	byReceiver, err = input.PeggyKeeper.DepositReceiptsByReceiver(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsByReceiverRequest{CosmosReceiver: myCosmosAddr.String()})
+	require.NoError(t, err)
+	assert.Empty(t, byReceiver.Receipts)
+	assert.Zero(t, byReceiver.Pagination.Total)
+	bySender, err = input.PeggyKeeper.DepositReceiptsBySender(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsBySenderRequest{EthereumSender: anyETHAddr})
+	require.NoError(t, err)
+	assert.Zero(t, bySender.Pagination.Total)
 }
 
 func TestMsgDepositClaimsMultiValidator(t *testing.T) {
@@ -231,7 +427,7 @@ func TestMsgDepositClaimsMultiValidator(t *testing.T) {
 	require.NotNil(t, a1)
 	// and vouchers not yet added to the account
 	balance1 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.NotEqual(t, sdk.Coins{sdk.NewInt64Coin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance1)
+	assert.NotEqual(t, sdk.Coins{sdk.NewInt64Coin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", 12)}, balance1)
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -244,7 +440,7 @@ func TestMsgDepositClaimsMultiValidator(t *testing.T) {
 	require.NotNil(t, a2)
 	// and vouchers now added to the account
 	balance2 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance2)
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", 12)}, balance2)
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -257,29 +453,162 @@ func TestMsgDepositClaimsMultiValidator(t *testing.T) {
 	require.NotNil(t, a3)
 	// and no additional added to the account
 	balance3 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
-	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("peggy0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", 12)}, balance3)
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("peggy0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", 12)}, balance3)
 }
 
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
-		ethAddress                   = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
-		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
-		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
-		blockTime                    = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
-		blockHeight   int64          = 200
+		ethKey, _                      = ethCrypto.GenerateKey()
+		ethAddress                     = ethCrypto.PubkeyToAddress(ethKey.PublicKey).Hex()
+		otherEthKey, _                 = ethCrypto.GenerateKey()
+		otherEthAddress                = ethCrypto.PubkeyToAddress(otherEthKey.PublicKey).Hex()
+		cosmosAddress   sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		otherCosmos     sdk.AccAddress = bytes.Repeat([]byte{0x3}, sdk.AddrLen)
+		valAddress      sdk.ValAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+		otherVal        sdk.ValAddress = bytes.Repeat([]byte{0x4}, sdk.AddrLen)
+		blockTime                      = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+		blockHeight     int64          = 200
 	)
 	input := keeper.CreateTestEnv(t)
-	input.PeggyKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress)
+	input.PeggyKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress, otherVal)
 	ctx := input.Context
 	h := NewHandler(input.PeggyKeeper)
 	ctx = ctx.WithBlockTime(blockTime)
 
-	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, ethAddress)
+	signFor := func(peggyID string, val sdk.ValAddress, nonce uint64, key *ecdsa.PrivateKey) string {
+		sig, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(peggyID, val, nonce), key)
+		require.NoError(t, err)
+		return hex.EncodeToString(sig)
+	}
+	sign := func(val sdk.ValAddress, nonce uint64, key *ecdsa.PrivateKey) string {
+		return signFor(input.PeggyKeeper.GetPeggyID(ctx), val, nonce, key)
+	}
+
+	// a signature by the wrong key is rejected
+	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, ethAddress, sign(valAddress, 0, otherEthKey))
 	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
 	_, err := h(ctx, msg)
+	require.Error(t, err)
+
+	// a signature given to another bridge is rejected
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, ethAddress, signFor("other-bridge", valAddress, 0, ethKey))
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, ethAddress, sign(valAddress, 0, ethKey))
+	_, err = h(ctx, msg)
 	require.NoError(t, err)
 
 	assert.Equal(t, input.PeggyKeeper.GetEthAddress(ctx, valAddress), ethAddress)
-
 	assert.Equal(t, input.PeggyKeeper.GetOrchestratorValidator(ctx, cosmosAddress), valAddress)
+	assert.Equal(t, input.PeggyKeeper.GetValidatorOrchestrator(ctx, valAddress), cosmosAddress)
+	assert.Equal(t, input.PeggyKeeper.GetEthAddressValidator(ctx, ethAddress), valAddress)
+
+	// the signature can not be replayed
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+
+	// another validator can not take over the keys in use
+	msg = types.NewMsgSetOrchestratorAddress(otherVal, cosmosAddress, otherEthAddress, sign(otherVal, 0, otherEthKey))
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+	msg = types.NewMsgSetOrchestratorAddress(otherVal, otherCosmos, ethAddress, sign(otherVal, 0, ethKey))
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+	msg = types.NewMsgSetOrchestratorAddress(otherVal, sdk.AccAddress(valAddress), otherEthAddress, sign(otherVal, 0, otherEthKey))
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+
+	// once set the keys can only be changed through a rotation
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, otherCosmos, otherEthAddress, sign(valAddress, 1, otherEthKey))
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+}
+
+func TestMsgRotateDelegateKeys(t *testing.T) {
+	var (
+		ethKey, _                    = ethCrypto.GenerateKey()
+		ethAddress                   = ethCrypto.PubkeyToAddress(ethKey.PublicKey).Hex()
+		newEthKey, _                 = ethCrypto.GenerateKey()
+		newEthAddress                = ethCrypto.PubkeyToAddress(newEthKey.PublicKey).Hex()
+		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		newCosmos     sdk.AccAddress = bytes.Repeat([]byte{0x3}, sdk.AddrLen)
+		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+		otherVal      sdk.ValAddress = bytes.Repeat([]byte{0x4}, sdk.AddrLen)
+		blockTime                    = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+		blockHeight   int64          = 200
+	)
+	input := keeper.CreateTestEnv(t)
+	input.PeggyKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress, otherVal)
+	k := input.PeggyKeeper
+	ctx := input.Context.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
+	h := NewHandler(k)
+
+	sign := func(val sdk.ValAddress, nonce uint64, key *ecdsa.PrivateKey) string {
+		sig, err := types.NewEthereumSignature(types.GetDelegateKeysSignHash(k.GetPeggyID(ctx), val, nonce), key)
+		require.NoError(t, err)
+		return hex.EncodeToString(sig)
+	}
+
+	// keys have to be set before they can be rotated
+	_, err := h(ctx, types.NewMsgRotateDelegateKeys(valAddress, newCosmos, newEthAddress, sign(valAddress, 0, newEthKey)))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, ethAddress, sign(valAddress, 0, ethKey)))
+	require.NoError(t, err)
+
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, newCosmos, newEthAddress, sign(valAddress, 1, newEthKey)))
+	require.NoError(t, err)
+	effectiveHeight := uint64(blockHeight) + keeper.TestingPeggyParams.DelegateKeyRotationGracePeriod
+	res, err := k.PendingDelegateKeyRotations(sdk.WrapSDKContext(ctx), &types.QueryPendingDelegateKeyRotationsRequest{Validator: valAddress.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Rotations, 1)
+	assert.Equal(t, effectiveHeight, res.Rotations[0].EffectiveHeight)
+
+	// keys that are about to be rotated to are already in use
+	_, err = h(ctx, types.NewMsgSetOrchestratorAddress(otherVal, newCosmos, ethAddress, sign(otherVal, 0, ethKey)))
+	require.Error(t, err)
+
+	// nothing changes before the grace period is over
+	ctx = ctx.WithBlockHeight(int64(effectiveHeight) - 1)
+	EndBlocker(ctx, k)
+	assert.Equal(t, ethAddress, k.GetEthAddress(ctx, valAddress))
+	assert.Equal(t, valAddress, k.GetOrchestratorValidator(ctx, cosmosAddress))
+
+	ctx = ctx.WithBlockHeight(int64(effectiveHeight)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, k)
+	assert.Equal(t, newEthAddress, k.GetEthAddress(ctx, valAddress))
+	assert.Equal(t, valAddress, k.GetOrchestratorValidator(ctx, newCosmos))
+	assert.Nil(t, k.GetOrchestratorValidator(ctx, cosmosAddress))
+	assert.Empty(t, k.GetPendingDelegateKeyRotations(ctx))
+
+	// the changed eth address forces a new valset
+	valsets := k.GetValsets(ctx)
+	require.NotEmpty(t, valsets)
+	assert.Equal(t, effectiveHeight, valsets[0].Height)
+
+	var found bool
+	for _, e := range ctx.EventManager().Events() {
+		found = found || e.Type == types.EventTypeDelegateKeyRotation
+	}
+	assert.True(t, found)
+
+	// the previous keys stay valid for objects created before the rotation
+	assert.Equal(t, valAddress, k.GetOrchestratorValidatorAt(ctx, cosmosAddress, effectiveHeight-1))
+	assert.Nil(t, k.GetOrchestratorValidatorAt(ctx, cosmosAddress, effectiveHeight))
+	assert.Equal(t, ethAddress, k.GetEthAddressAt(ctx, valAddress, effectiveHeight-1))
+	assert.Equal(t, newEthAddress, k.GetEthAddressAt(ctx, valAddress, effectiveHeight))
+
+	// and can not be taken by another validator or rotated over in the meantime
+	_, err = h(ctx, types.NewMsgSetOrchestratorAddress(otherVal, cosmosAddress, ethAddress, sign(otherVal, 0, ethKey)))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(valAddress, cosmosAddress, ethAddress, sign(valAddress, 2, ethKey)))
+	require.Error(t, err)
+
+	// once the signing windows are over the previous keys are released
+	ctx = ctx.WithBlockHeight(int64(effectiveHeight + keeper.TestingPeggyParams.SignedValsetsWindow + 1))
+	k.ApplyDelegateKeyRotations(ctx)
+	assert.Nil(t, k.GetPreviousDelegateKeys(ctx, valAddress))
+	assert.Nil(t, k.GetOrchestratorValidatorAt(ctx, cosmosAddress, effectiveHeight-1))
+	_, err = h(ctx, types.NewMsgSetOrchestratorAddress(otherVal, cosmosAddress, ethAddress, sign(otherVal, 0, ethKey)))
+	require.NoError(t, err)
 }
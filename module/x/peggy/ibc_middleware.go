@@ -0,0 +1,150 @@
+package peggy
+
+import (
+	"fmt"
+
+	"github.com/althea-net/peggy/module/x/peggy/keeper"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+)
+
+// IBCMiddleware wraps the ICS-20 transfer module so tokens transferred to a receiver carrying a
+// send to eth instruction enter the outgoing pool on behalf of the receiver. It also resolves the
+// non checksummed denoms other chains still trace peggy vouchers by to the checksummed denom the
+// vouchers escrowed for them were swapped for by the store migration to version 4
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	k keeper.Keeper
+}
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// NewIBCMiddleware wraps the transfer module app
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, k: k}
+}
+
+// OnRecvPacket credits the transferred tokens to the receiver named in a send to eth instruction and
+// adds them to the outgoing pool. If either fails nothing is written and an error acknowledgement
+// refunds the sender on the other chain.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, []byte, error) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet)
+	}
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// returning vouchers are unescrowed in their checksummed denom
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		if denom := voucherPrefix + types.NormalizePeggyDenom(data.Denom[len(voucherPrefix):]); denom != data.Denom {
+			data.Denom = denom
+			packet.Data = ibctransfertypes.ModuleCdc.MustMarshalJSON(&data)
+		}
+	}
+	instruction, err := types.ParseSendToEthInstruction(data.Receiver)
+	if err != nil {
+		return errorAcknowledgement(ctx, err)
+	}
+	if instruction == nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet)
+	}
+
+	// the transfer module credits the receiver of the instruction
+	data.Receiver = instruction.Receiver.String()
+	packet.Data = ibctransfertypes.ModuleCdc.MustMarshalJSON(&data)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	_, ack, err := im.IBCModule.OnRecvPacket(cacheCtx, packet)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := acknowledgementError(ack); err != nil {
+		return errorAcknowledgement(ctx, err)
+	}
+
+	denom := receivedDenom(packet, data)
+	amount := sdk.NewIntFromUint64(data.Amount)
+	if instruction.BridgeFee.GTE(amount) {
+		return errorAcknowledgement(ctx, sdkerrors.Wrapf(types.ErrInvalid, "bridge fee %s exceeds amount %s", instruction.BridgeFee, amount))
+	}
+	txID, err := im.k.AddToOutgoingPool(
+		cacheCtx,
+		instruction.Receiver,
+		instruction.EthDest,
+		sdk.NewCoin(denom, amount.Sub(instruction.BridgeFee)),
+		sdk.NewCoin(denom, instruction.BridgeFee),
+	)
+	if err != nil {
+		return errorAcknowledgement(ctx, err)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeIBCSendToEth,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+		sdk.NewAttribute(types.AttributeKeyEthAddress, instruction.EthDest),
+	))
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, ack, nil
+}
+
+// OnAcknowledgementPacket refunds the escrowed vouchers of a failed transfer in their checksummed denom
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) (*sdk.Result, error) {
+	return im.IBCModule.OnAcknowledgementPacket(ctx, withSentVoucherDenom(packet), acknowledgement)
+}
+
+// OnTimeoutPacket refunds the escrowed vouchers of a timed out transfer in their checksummed denom
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+	return im.IBCModule.OnTimeoutPacket(ctx, withSentVoucherDenom(packet))
+}
+
+// withSentVoucherDenom replaces the denom of peggy vouchers sent from this chain by its checksummed
+// form, packets sent before the store migration to version 4 may carry any casing
+func withSentVoucherDenom(packet channeltypes.Packet) channeltypes.Packet {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return packet
+	}
+	if denom := types.NormalizePeggyDenom(data.Denom); denom != data.Denom {
+		data.Denom = denom
+		packet.Data = ibctransfertypes.ModuleCdc.MustMarshalJSON(&data)
+	}
+	return packet
+}
+
+// receivedDenom returns the denom the transfer module credits for a packet
+func receivedDenom(packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) string {
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens return to this chain, unprefixed denoms are native ones
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return ibctransfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	}
+	sourcePrefix := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return ibctransfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+}
+
+// acknowledgementError returns the error of an error acknowledgement
+func acknowledgementError(bz []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return sdkerrors.Wrap(err, "acknowledgement")
+	}
+	if resp, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		return sdkerrors.Wrap(types.ErrInvalid, resp.Error)
+	}
+	return nil
+}
+
+func errorAcknowledgement(ctx sdk.Context, err error) (*sdk.Result, []byte, error) {
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, channeltypes.NewErrorAcknowledgement(err.Error()).GetBytes(), nil
+}
@@ -0,0 +1,159 @@
+package peggy
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/keeper"
+	"github.com/althea-net/peggy/module/x/peggy/migrations"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTransferModule credits the receiver of a packet with the received denom, tokens returning to
+// this chain are unescrowed like ICS-20 does
+type mockTransferModule struct {
+	porttypes.IBCModule
+	input keeper.TestInput
+}
+
+func (m mockTransferModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, []byte, error) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	ibctransfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return &sdk.Result{}, channeltypes.NewErrorAcknowledgement(err.Error()).GetBytes(), nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(receivedDenom(packet, data), sdk.NewIntFromUint64(data.Amount)))
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		escrow := ibctransfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := m.input.BankKeeper.SendCoins(ctx, escrow, receiver, coins); err != nil {
+			return &sdk.Result{}, channeltypes.NewErrorAcknowledgement(err.Error()).GetBytes(), nil
+		}
+		return &sdk.Result{}, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes(), nil
+	}
+	if err := m.input.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, nil, err
+	}
+	if err := m.input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, nil, err
+	}
+	return &sdk.Result{}, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes(), nil
+}
+
+// OnTimeoutPacket refunds the native tokens a packet escrowed to its sender
+func (m mockTransferModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	ibctransfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return nil, err
+	}
+	escrow := ibctransfertypes.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+	coins := sdk.NewCoins(sdk.NewCoin(data.Denom, sdk.NewIntFromUint64(data.Amount)))
+	return &sdk.Result{}, m.input.BankKeeper.SendCoins(ctx, escrow, sender, coins)
+}
+
+func TestIBCMiddlewareSendToEth(t *testing.T) {
+	var (
+		receiver, _  = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		ethDest      = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		tokenETHAddr = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		voucherDenom = types.PeggyDenom(tokenETHAddr)
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	im := NewIBCMiddleware(mockTransferModule{input: input}, input.PeggyKeeper)
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, escrowed))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ibctransfertypes.GetEscrowAddress("transfer", "channel-0"), escrowed))
+
+	// peggy vouchers returning from the chain at the other end of channel-7
+	recv := func(receiver string, amount uint64) []byte {
+		data := ibctransfertypes.NewFungibleTokenPacketData("transfer/channel-7/"+voucherDenom, amount, "osmo1sender", receiver)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-7", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+		_, ack, err := im.OnRecvPacket(ctx, packet)
+		require.NoError(t, err)
+		return ack
+	}
+	countPool := func() (count int) {
+		input.PeggyKeeper.IterateOutgoingPoolByFee(ctx, tokenETHAddr, func(_ uint64, tx *types.OutgoingTx) bool {
+			assert.Equal(t, receiver.String(), tx.Sender)
+			assert.Equal(t, ethDest, tx.DestAddr)
+			count++
+			return false
+		})
+		return count
+	}
+
+	// when
+	ack := recv(receiver.String()+"/"+ethDest+"/10", 100)
+
+	// then the tokens are in the pool on behalf of the receiver
+	require.NoError(t, acknowledgementError(ack))
+	assert.Equal(t, 1, countPool())
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, receiver).IsZero())
+
+	// plain receivers are credited as usual
+	require.NoError(t, acknowledgementError(recv(receiver.String(), 100)))
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(voucherDenom, 100)}, input.BankKeeper.GetAllBalances(ctx, receiver))
+
+	// a fee exceeding the amount or a malformed instruction gives an error acknowledgement and writes nothing
+	for _, instruction := range []string{
+		receiver.String() + "/" + ethDest + "/100",
+		receiver.String() + "/" + ethDest,
+		receiver.String() + "/0xnotanaddress/1",
+	} {
+		assert.Error(t, acknowledgementError(recv(instruction, 100)), instruction)
+	}
+	assert.Equal(t, 1, countPool())
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(voucherDenom, 100)}, input.BankKeeper.GetAllBalances(ctx, receiver))
+}
+
+func TestIBCMiddlewareVouchersAfterMigration(t *testing.T) {
+	var (
+		receiver, _  = sdk.AccAddressFromBech32("cosmos16ahjkfqxpp6lvfy9fpfnfjg39xr96qett0alj5")
+		sender       = sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+		tokenETHAddr = "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+		lowerDenom   = "peggy" + strings.ToLower(tokenETHAddr)
+		voucherDenom = types.PeggyDenom(tokenETHAddr)
+		escrow       = ibctransfertypes.GetEscrowAddress("transfer", "channel-0")
+	)
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	im := NewIBCMiddleware(mockTransferModule{input: input}, input.PeggyKeeper)
+
+	// vouchers of version 3 were sent to the chain at the other end of channel-0 in the casing of
+	// the claim, that chain traces them by the lowercase denom
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(lowerDenom, 130))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, escrowed))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrow, escrowed))
+	migrations.SetStoreVersion(ctx, input.PeggyStoreKey, 3)
+	require.NoError(t, keeper.NewMigrator(input.PeggyKeeper).Migrate(ctx))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(voucherDenom, 130)}, input.BankKeeper.GetAllBalances(ctx, escrow))
+
+	// when the vouchers return by the lowercase denom
+	data := ibctransfertypes.NewFungibleTokenPacketData("transfer/channel-7/"+lowerDenom, 100, "osmo1sender", receiver.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-7", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	_, ack, err := im.OnRecvPacket(ctx, packet)
+	require.NoError(t, err)
+
+	// then they are unescrowed in the checksummed denom
+	require.NoError(t, acknowledgementError(ack))
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(voucherDenom, 100)}, input.BankKeeper.GetAllBalances(ctx, receiver))
+
+	// and a transfer sent before the migration is refunded in the checksummed denom when it times out
+	data = ibctransfertypes.NewFungibleTokenPacketData(lowerDenom, 30, sender.String(), "osmo1receiver")
+	packet = channeltypes.NewPacket(data.GetBytes(), 2, "transfer", "channel-0", "transfer", "channel-7", clienttypes.NewHeight(0, 100), 0)
+	_, err = im.OnTimeoutPacket(ctx, packet)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin(voucherDenom, 30)}, input.BankKeeper.GetAllBalances(ctx, sender))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, escrow).IsZero())
+}
@@ -110,6 +110,8 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 		)
 	} else {
 		commit() // persist transient storage
+		// the cache context collects events separately, they are only emitted on success
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 	}
 }
 
@@ -221,11 +223,15 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
 	height := types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 	}
+	k.setLastObservedEthereumBlockHeight(ctx, height)
+}
+
+func (k Keeper) setLastObservedEthereumBlockHeight(ctx sdk.Context, height types.LastObservedEthereumBlockHeight) {
+	store := ctx.KVStore(k.storeKey)
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshalBinaryBare(&height))
 }
 
@@ -282,6 +288,16 @@ func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	return types.UInt64FromBytes(bytes)
 }
 
+// getStoredLastEventNonceByValidator returns the event nonce of the last claim of a validator
+// and false if it never submitted a claim
+func (k Keeper) getStoredLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) (uint64, bool) {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetLastEventNonceByValidatorKey(validator))
+	if len(bytes) == 0 {
+		return 0, false
+	}
+	return types.UInt64FromBytes(bytes), true
+}
+
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
 func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -21,38 +21,62 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	case *types.MsgDepositClaim:
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := a.keeper.ERC20ToDenom(ctx, claim.TokenContract)
-
-		if isCosmosOriginated {
-			// If it is cosmos originated, unlock the coins
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
+		coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
+
+		channel, remoteReceiver, forward := types.ParseForwardingReceiver(claim.CosmosReceiver)
+		var addr sdk.AccAddress
+		if forward {
+			// forwarded coins are sent from the local account of the Ethereum sender, which keeps
+			// them if the transfer fails
+			addr = types.EthereumSenderAccount(claim.EthereumSender)
+		} else {
+			var err error
+			addr, err = sdk.AccAddressFromBech32(claim.CosmosReceiver)
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid reciever address")
 			}
+		}
 
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
-		} else {
+		if !isCosmosOriginated {
 			// If it is not cosmos originated, mint the coins (aka vouchers)
-			coins := sdk.Coins{sdk.NewCoin(denom, claim.Amount)}
-
 			if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 			}
+		}
+		// If it is cosmos originated, this unlocks the coins
+		if err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return sdkerrors.Wrap(err, "transfer vouchers")
+		}
 
-			addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid reciever address")
-			}
-
-			if err = a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return sdkerrors.Wrap(err, "transfer vouchers")
-			}
+		a.keeper.SetDepositReceipt(ctx, &types.DepositReceipt{
+			EventNonce:     claim.EventNonce,
+			EthereumHeight: claim.BlockHeight,
+			EthereumSender: claim.EthereumSender,
+			CosmosReceiver: addr.String(),
+			TokenContract:  claim.TokenContract,
+			Denom:          denom,
+			Amount:         claim.Amount,
+			Height:         uint64(ctx.BlockHeight()),
+		})
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBridgeDepositReceived,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyEthereumSender, claim.EthereumSender),
+			sdk.NewAttribute(types.AttributeKeyCosmosReceiver, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, claim.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, denom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(claim.BlockHeight)),
+		))
+
+		if forward {
+			a.keeper.forwardDeposit(ctx, addr, coins[0], channel, remoteReceiver)
 		}
 	case *types.MsgWithdrawClaim:
-		a.keeper.OutgoingTxBatchExecuted(ctx, claim.TokenContract, claim.BatchNonce)
+		a.keeper.OutgoingTxBatchExecuted(ctx, claim.TokenContract, claim.BatchNonce, claim.EventNonce)
+	case *types.MsgLogicCallExecutedClaim:
+		return a.keeper.OutgoingLogicCallExecuted(ctx, claim.InvalidationId, claim.InvalidationNonce)
 	case *types.MsgERC20DeployedClaim:
 		// Check if it already exists
 		existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom)
@@ -62,54 +86,36 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20, claim.CosmosDenom))
 		}
 
-		// Check if denom exists
-		metadata := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
-		if metadata.Base == "" {
-			return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", claim.CosmosDenom))
+		// Only deployments governance approved are accepted
+		req := a.keeper.GetERC20DeploymentRequest(ctx, claim.CosmosDenom)
+		if req == nil {
+			return sdkerrors.Wrap(
+				types.ErrUnknown,
+				fmt.Sprintf("no approved ERC20 deployment for denom %s", claim.CosmosDenom))
 		}
 
-		// Check if attributes of ERC20 match Cosmos denom
-		if claim.Name != metadata.Display {
+		// Check if attributes of ERC20 match the approved deployment
+		if claim.Name != req.Name {
 			return sdkerrors.Wrap(
 				types.ErrInvalid,
-				fmt.Sprintf("ERC20 name %s does not match denom display %s", claim.Name, metadata.Description))
+				fmt.Sprintf("ERC20 name %s does not match approved name %s", claim.Name, req.Name))
 		}
 
-		if claim.Symbol != metadata.Display {
+		if claim.Symbol != req.Symbol {
 			return sdkerrors.Wrap(
 				types.ErrInvalid,
-				fmt.Sprintf("ERC20 symbol %s does not match denom display %s", claim.Symbol, metadata.Display))
-		}
-
-		// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
-		// The "decimals" field simply tells you how many decimal places there will be.
-		// Cosmos denoms have a system that is much more full featured, with enterprise-ready token denominations.
-		// There is a DenomUnits array that tells you what the name of each denomination of the
-		// token is.
-		// To correlate this with an ERC20 "decimals" field, we have to search through the DenomUnits array
-		// to find the DenomUnit which matches up to the main token "display" value. Then we take the
-		// "exponent" from this DenomUnit.
-		// If the correct DenomUnit is not found, it will default to 0. This will result in there being no decimal places
-		// in the token's ERC20 on Ethereum. So, for example, if this happened with Atom, 1 Atom would appear on Ethereum
-		// as 1 million Atoms, having 6 extra places before the decimal point.
-		// This will only happen with a Denom Metadata which is for all intents and purposes invalid, but I am not sure
-		// this is checked for at any other point.
-		decimals := uint32(0)
-		for _, denomUnit := range metadata.DenomUnits {
-			if denomUnit.Denom == metadata.Display {
-				decimals = denomUnit.Exponent
-				break
-			}
+				fmt.Sprintf("ERC20 symbol %s does not match approved symbol %s", claim.Symbol, req.Symbol))
 		}
 
-		if decimals != uint32(claim.Decimals) {
+		if claim.Decimals != req.Decimals {
 			return sdkerrors.Wrap(
 				types.ErrInvalid,
-				fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", claim.Decimals, decimals))
+				fmt.Sprintf("ERC20 decimals %d does not match approved decimals %d", claim.Decimals, req.Decimals))
 		}
 
 		// Add to denom-erc20 mapping
 		a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, claim.TokenContract)
+		a.keeper.DeleteERC20DeploymentRequest(ctx, claim.CosmosDenom)
 
 	default:
 		return sdkerrors.Wrapf(types.ErrInvalid, "event type: %s", claim.GetType())
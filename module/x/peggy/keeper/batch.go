@@ -21,6 +21,7 @@ func (k Keeper) BuildOutgoingTXBatch(ctx sdk.Context, contractAddress string, ma
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
+	contractAddress = types.NormalizeEthAddress(contractAddress)
 	selectedTx, err := k.pickUnbatchedTX(ctx, contractAddress, maxElements)
 	if len(selectedTx) == 0 || err != nil {
 		return nil, err
@@ -67,8 +68,9 @@ func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
-// It frees all the transactions in the batch, then cancels all earlier batches
-func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract string, nonce uint64) error {
+// It frees all the transactions in the batch, recording them as executed at the event nonce,
+// then cancels all earlier batches
+func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract string, nonce uint64, eventNonce uint64) error {
 	b := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
 	if b == nil {
 		return sdkerrors.Wrap(types.ErrUnknown, "nonce")
@@ -77,6 +79,13 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract string, n
 	// cleanup outgoing TX pool
 	for _, tx := range b.Transactions {
 		k.removePoolEntry(ctx, tx.Id)
+		k.setTransferRecord(ctx, &types.TransferRecord{
+			TxId:       tx.Id,
+			State:      types.TRANSFER_STATE_EXECUTED,
+			BatchNonce: nonce,
+			EventNonce: eventNonce,
+			Height:     uint64(ctx.BlockHeight()),
+		})
 	}
 
 	// Iterate through remaining batches
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -73,6 +74,8 @@ func TestBatches(t *testing.T) {
 		Block:         1234567,
 	}
 	assert.Equal(t, expFirstBatch, gotFirstBatch)
+	// store keys do not depend on the casing of the contract
+	assert.NotNil(t, input.PeggyKeeper.GetOutgoingTXBatch(ctx, strings.ToLower(myTokenContractAddr), firstBatch.BatchNonce))
 
 	// and verify remaining available Tx in the pool
 	var gotUnbatchedTx []*types.OutgoingTx
@@ -143,7 +146,7 @@ func TestBatches(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.PeggyKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
+	input.PeggyKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce, 1)
 
 	// check batch has been deleted
 	gotSecondBatch := input.PeggyKeeper.GetOutgoingTXBatch(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
@@ -322,7 +325,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.PeggyKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
+	input.PeggyKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, secondBatch.BatchNonce, 1)
 
 	// check batch has been deleted
 	gotSecondBatch := input.PeggyKeeper.GetOutgoingTXBatch(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
@@ -2,9 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"math"
+	"strings"
 
 	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 )
 
 func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, tokenContract string) (string, bool) {
@@ -29,10 +35,25 @@ func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, denom string) (string,
 
 func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract string) {
 	store := ctx.KVStore(k.storeKey)
+	tokenContract = types.NormalizeEthAddress(tokenContract)
 	store.Set(types.GetDenomToERC20Key(denom), []byte(tokenContract))
 	store.Set(types.GetERC20ToDenomKey(tokenContract), []byte(denom))
 }
 
+// IterateCosmosOriginatedDenoms iterates over the Cosmos originated denoms and the ERC20s representing them
+func (k Keeper) IterateCosmosOriginatedDenoms(ctx sdk.Context, cb func(denom, tokenContract string) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomToERC20Key)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(string(iter.Key()), string(iter.Value())) {
+			return
+		}
+	}
+}
+
 // DenomToERC20 returns (bool isCosmosOriginated, string ERC20, err)
 // Using this information, you can see if an asset is native to Cosmos or Ethereum, and get its corresponding ERC20 address
 // This will return an error if it cant parse the denom as a peggy denom, and then also can't find the denom
@@ -69,3 +90,227 @@ func (k Keeper) ERC20ToDenom(ctx sdk.Context, tokenContract string) (bool, strin
 		return false, types.PeggyDenom(tokenContract)
 	}
 }
+
+// SetERC20Metadata registers the bank metadata of the voucher of an Ethereum originated ERC20 so
+// clients can display it with its symbol and decimals. Metadata observed later replaces the earlier one,
+// a symbol that is not a valid denom or names another coin is rejected
+func (k Keeper) SetERC20Metadata(ctx sdk.Context, tokenContract, name, symbol string, decimals uint64) error {
+	isCosmosOriginated, denom := k.ERC20ToDenom(ctx, tokenContract)
+	if isCosmosOriginated {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 %s represents Cosmos originated denom %s", tokenContract, denom))
+	}
+
+	metadata := bank.Metadata{
+		Description: name,
+		DenomUnits:  []*bank.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+	}
+	// the voucher is displayed with the symbol of the ERC20, scaled by its decimals
+	if symbol != "" && symbol != denom {
+		if err := k.validateERC20Symbol(ctx, denom, symbol); err != nil {
+			return err
+		}
+		metadata.DenomUnits = append(metadata.DenomUnits, &bank.DenomUnit{Denom: symbol, Exponent: uint32(decimals)})
+		metadata.Display = symbol
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeERC20Metadata,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, tokenContract),
+		sdk.NewAttribute(types.AttributeKeyERC20Name, name),
+		sdk.NewAttribute(types.AttributeKeyERC20Symbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyERC20Decimals, fmt.Sprint(decimals)),
+	))
+	return nil
+}
+
+// GetERC20DeploymentRequest returns the ERC20 deployment governance approved for a denom, if any
+func (k Keeper) GetERC20DeploymentRequest(ctx sdk.Context, denom string) *types.ERC20DeploymentRequest {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetERC20DeploymentRequestKey(denom))
+	if bz == nil {
+		return nil
+	}
+	var req types.ERC20DeploymentRequest
+	k.cdc.MustUnmarshalBinaryBare(bz, &req)
+	return &req
+}
+
+// SetERC20DeploymentRequest stores an ERC20 deployment approved by governance
+func (k Keeper) SetERC20DeploymentRequest(ctx sdk.Context, req *types.ERC20DeploymentRequest) {
+	ctx.KVStore(k.storeKey).Set(types.GetERC20DeploymentRequestKey(req.Denom), k.cdc.MustMarshalBinaryBare(req))
+}
+
+// IterateERC20DeploymentRequests iterates through the ERC20 deployments approved by governance
+func (k Keeper) IterateERC20DeploymentRequests(ctx sdk.Context, cb func(*types.ERC20DeploymentRequest) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyERC20DeploymentRequest)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var req types.ERC20DeploymentRequest
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &req)
+		// cb returns true to stop early
+		if cb(&req) {
+			break
+		}
+	}
+}
+
+// DeleteERC20DeploymentRequest deletes the ERC20 deployment approved for a denom
+func (k Keeper) DeleteERC20DeploymentRequest(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetERC20DeploymentRequestKey(denom))
+}
+
+// ApproveERC20Deployment checks a deployment governance approved against the metadata of the
+// denom, stores it and emits an event for the orchestrators to deploy the ERC20. IBC vouchers
+// without metadata are accepted if their trace is known and the approved name, symbol and
+// decimals are valid. A later approval for the same denom replaces the earlier one
+func (k Keeper) ApproveERC20Deployment(ctx sdk.Context, req *types.ERC20DeploymentRequest) error {
+	// Check if it already exists
+	if existingERC20, exists := k.GetCosmosOriginatedERC20(ctx, req.Denom); exists {
+		return sdkerrors.Wrapf(types.ErrInvalid, "ERC20 %s already exists for denom %s", existingERC20, req.Denom)
+	}
+
+	// Check if denom exists
+	metadata := k.bankKeeper.GetDenomMetaData(ctx, req.Denom)
+	if metadata.Base == "" {
+		// IBC vouchers normally have no metadata, the name, symbol and decimals governance
+		// approved are the only metadata they have
+		trace, err := k.GetIBCDenomTrace(ctx, req.Denom)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", req.Denom))
+		}
+		if strings.TrimSpace(req.Name) == "" {
+			return sdkerrors.Wrap(types.ErrEmpty, "ERC20 name")
+		}
+		if req.Decimals > math.MaxUint8 {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("ERC20 decimals %d exceed %d", req.Decimals, math.MaxUint8))
+		}
+		if err := k.validateERC20Symbol(ctx, req.Denom, req.Symbol); err != nil {
+			return err
+		}
+		k.SetERC20DeploymentRequest(ctx, req)
+		k.emitERC20DeploymentRequestEvent(ctx, req,
+			sdk.NewAttribute(types.AttributeKeyIBCBaseDenom, trace.BaseDenom),
+			sdk.NewAttribute(types.AttributeKeyIBCPath, trace.Path),
+		)
+		return nil
+	}
+
+	// Check if attributes of ERC20 match Cosmos denom
+	if req.Name != metadata.Display {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 name %s does not match denom display %s", req.Name, metadata.Display))
+	}
+
+	if req.Symbol != metadata.Display {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 symbol %s does not match denom display %s", req.Symbol, metadata.Display))
+	}
+
+	// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
+	// The "decimals" field simply tells you how many decimal places there will be.
+	// Cosmos denoms have a system that is much more full featured, with enterprise-ready token denominations.
+	// There is a DenomUnits array that tells you what the name of each denomination of the
+	// token is.
+	// To correlate this with an ERC20 "decimals" field, we have to search through the DenomUnits array
+	// to find the DenomUnit which matches up to the main token "display" value. Then we take the
+	// "exponent" from this DenomUnit.
+	// If the correct DenomUnit is not found, it will default to 0. This will result in there being no decimal places
+	// in the token's ERC20 on Ethereum. So, for example, if this happened with Atom, 1 Atom would appear on Ethereum
+	// as 1 million Atoms, having 6 extra places before the decimal point.
+	// This will only happen with a Denom Metadata which is for all intents and purposes invalid, but I am not sure
+	// this is checked for at any other point.
+	decimals := uint32(0)
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			decimals = denomUnit.Exponent
+			break
+		}
+	}
+
+	if decimals != uint32(req.Decimals) {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", req.Decimals, decimals))
+	}
+
+	k.SetERC20DeploymentRequest(ctx, req)
+	k.emitERC20DeploymentRequestEvent(ctx, req)
+	return nil
+}
+
+// validateERC20Symbol checks that the symbol of an ERC20 representing denom is a valid denom
+// that does not name any other coin, neither one with supply nor a unit in bank metadata
+func (k Keeper) validateERC20Symbol(ctx sdk.Context, denom, symbol string) error {
+	if err := sdk.ValidateDenom(symbol); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("ERC20 symbol %s: %s", symbol, err))
+	}
+	if symbol == denom {
+		return nil
+	}
+	if !k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(symbol).IsZero() {
+		return sdkerrors.Wrap(types.ErrDuplicate, fmt.Sprintf("ERC20 symbol %s is an existing denom", symbol))
+	}
+	var err error
+	k.bankKeeper.IterateAllDenomMetaData(ctx, func(metadata bank.Metadata) bool {
+		// the metadata of denom itself is replaced
+		if metadata.Base == denom {
+			return false
+		}
+		names := []string{metadata.Base, metadata.Display}
+		for _, unit := range metadata.DenomUnits {
+			names = append(append(names, unit.Denom), unit.Aliases...)
+		}
+		for _, name := range names {
+			if name == symbol {
+				err = sdkerrors.Wrap(types.ErrDuplicate, fmt.Sprintf("ERC20 symbol %s is a unit of %s", symbol, metadata.Base))
+				return true
+			}
+		}
+		return false
+	})
+	return err
+}
+
+// emitERC20DeploymentRequestEvent emits the event the orchestrators watch for to deploy the ERC20
+// through the bridge contract
+func (k Keeper) emitERC20DeploymentRequestEvent(ctx sdk.Context, req *types.ERC20DeploymentRequest, attrs ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeERC20DeploymentRequest,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, fmt.Sprint(k.GetBridgeChainID(ctx))),
+		sdk.NewAttribute(types.AttributeKeyCosmosDenom, req.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Name, req.Name),
+		sdk.NewAttribute(types.AttributeKeyERC20Symbol, req.Symbol),
+		sdk.NewAttribute(types.AttributeKeyERC20Decimals, fmt.Sprint(req.Decimals)),
+	).AppendAttributes(attrs...))
+}
+
+// GetIBCDenomTrace resolves the trace of an IBC voucher denom of the form ibc/{hash}
+func (k Keeper) GetIBCDenomTrace(ctx sdk.Context, denom string) (ibctransfertypes.DenomTrace, error) {
+	if err := ibctransfertypes.ValidateIBCDenom(denom); err != nil {
+		return ibctransfertypes.DenomTrace{}, err
+	}
+	denomSplit := strings.SplitN(denom, "/", 2)
+	if len(denomSplit) != 2 || denomSplit[0] != ibctransfertypes.DenomPrefix {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.Wrapf(ibctransfertypes.ErrInvalidDenomForTransfer, "not an IBC voucher: %s", denom)
+	}
+	hash, err := ibctransfertypes.ParseHexHash(denomSplit[1])
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, err
+	}
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return ibctransfertypes.DenomTrace{}, sdkerrors.Wrap(ibctransfertypes.ErrTraceNotFound, denom)
+	}
+	return trace, nil
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verifyDelegateKeysSignature checks that the ethereum key consents to being used
+// by the validator, it must have signed over the peggy id, the validator address and
+// the current delegate key nonce of the validator
+func (k Keeper) verifyDelegateKeysSignature(ctx sdk.Context, val sdk.ValAddress, ethAddr string, ethSig string) error {
+	sigBytes, err := hex.DecodeString(ethSig)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+	nonce := k.GetDelegateKeyNonce(ctx, val)
+	if err = types.ValidateEthereumSignature(types.GetDelegateKeysSignHash(k.GetPeggyID(ctx), val, nonce), sigBytes, ethAddr); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s over validator %s with nonce %d found %s", ethAddr, val, nonce, ethSig))
+	}
+	return nil
+}
+
+// checkDelegateKeysAvailable returns an error if the orchestrator or ethereum address is in
+// use by any validator other than val. Delegate keys must be unique across validators,
+// otherwise a validator could claim another validators keys and have its signatures and
+// claims counted as its own. Keys that are scheduled to be rotated to or that are still
+// valid after being rotated away from are considered in use
+func (k Keeper) checkDelegateKeysAvailable(ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress, ethAddr string) error {
+	if other := k.GetOrchestratorValidator(ctx, orch); other != nil && !other.Equals(val) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "orchestrator %s already in use by %s", orch, other)
+	}
+	if other := k.StakingKeeper.Validator(ctx, sdk.ValAddress(orch)); other != nil && !other.GetOperator().Equals(val) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "orchestrator %s is the operator of another validator", orch)
+	}
+	if other := k.GetEthAddressValidator(ctx, ethAddr); other != nil && !other.Equals(val) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "ethereum address %s already in use by %s", ethAddr, other)
+	}
+
+	var err error
+	inUse := func(keys *types.DelegateKeyRotation) bool {
+		if keys.Validator == val.String() {
+			return false
+		}
+		if keys.Orchestrator == orch.String() {
+			err = sdkerrors.Wrapf(types.ErrDuplicate, "orchestrator %s already in use by %s", orch, keys.Validator)
+			return true
+		}
+		if keys.EthAddress == ethAddr {
+			err = sdkerrors.Wrapf(types.ErrDuplicate, "ethereum address %s already in use by %s", ethAddr, keys.Validator)
+			return true
+		}
+		return false
+	}
+	k.IteratePendingDelegateKeyRotations(ctx, inUse)
+	if err != nil {
+		return err
+	}
+	k.IteratePreviousDelegateKeys(ctx, inUse)
+	return err
+}
+
+/////////////////////////////
+//  DELEGATE KEY ROTATION  //
+/////////////////////////////
+
+// SetPendingDelegateKeyRotation schedules a delegate key rotation, replacing any
+// rotation already scheduled for the validator
+func (k Keeper) SetPendingDelegateKeyRotation(ctx sdk.Context, rotation *types.DelegateKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	val, err := sdk.ValAddressFromBech32(rotation.Validator)
+	if err != nil {
+		panic(err)
+	}
+	normalized := *rotation
+	normalized.EthAddress = types.NormalizeEthAddress(normalized.EthAddress)
+	store.Set(types.GetPendingDelegateKeyRotationKey(val), k.cdc.MustMarshalBinaryBare(&normalized))
+}
+
+// GetPendingDelegateKeyRotation returns the rotation scheduled for a validator, if any
+func (k Keeper) GetPendingDelegateKeyRotation(ctx sdk.Context, val sdk.ValAddress) *types.DelegateKeyRotation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingDelegateKeyRotationKey(val))
+	if bz == nil {
+		return nil
+	}
+	var rotation types.DelegateKeyRotation
+	k.cdc.MustUnmarshalBinaryBare(bz, &rotation)
+	return &rotation
+}
+
+// IteratePendingDelegateKeyRotations iterates through all scheduled rotations
+func (k Keeper) IteratePendingDelegateKeyRotations(ctx sdk.Context, cb func(*types.DelegateKeyRotation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPendingDelegateKeyRotation)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.DelegateKeyRotation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &rotation)
+		// cb returns true to stop early
+		if cb(&rotation) {
+			break
+		}
+	}
+}
+
+// GetPendingDelegateKeyRotations returns all scheduled rotations
+func (k Keeper) GetPendingDelegateKeyRotations(ctx sdk.Context) (out []*types.DelegateKeyRotation) {
+	k.IteratePendingDelegateKeyRotations(ctx, func(rotation *types.DelegateKeyRotation) bool {
+		out = append(out, rotation)
+		return false
+	})
+	return
+}
+
+// GetPreviousDelegateKeys returns the keys a validator rotated away from while they are
+// still valid for objects created before the rotation, the EffectiveHeight is the height
+// the rotation took effect at
+func (k Keeper) GetPreviousDelegateKeys(ctx sdk.Context, val sdk.ValAddress) *types.DelegateKeyRotation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPreviousDelegateKeysKey(val))
+	if bz == nil {
+		return nil
+	}
+	var keys types.DelegateKeyRotation
+	k.cdc.MustUnmarshalBinaryBare(bz, &keys)
+	return &keys
+}
+
+// IteratePreviousDelegateKeys iterates through the keys validators rotated away from
+func (k Keeper) IteratePreviousDelegateKeys(ctx sdk.Context, cb func(*types.DelegateKeyRotation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPreviousDelegateKeys)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var keys types.DelegateKeyRotation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &keys)
+		// cb returns true to stop early
+		if cb(&keys) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setPreviousDelegateKeys(ctx sdk.Context, val sdk.ValAddress, keys *types.DelegateKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	normalized := *keys
+	normalized.EthAddress = types.NormalizeEthAddress(normalized.EthAddress)
+	store.Set(types.GetPreviousDelegateKeysKey(val), k.cdc.MustMarshalBinaryBare(&normalized))
+	if orch, err := sdk.AccAddressFromBech32(keys.Orchestrator); err == nil {
+		store.Set(types.GetPreviousOrchestratorKey(orch), val.Bytes())
+	}
+}
+
+func (k Keeper) deletePreviousDelegateKeys(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if keys := k.GetPreviousDelegateKeys(ctx, val); keys != nil {
+		if orch, err := sdk.AccAddressFromBech32(keys.Orchestrator); err == nil {
+			store.Delete(types.GetPreviousOrchestratorKey(orch))
+		}
+	}
+	store.Delete(types.GetPreviousDelegateKeysKey(val))
+}
+
+// GetOrchestratorValidatorAt returns the validator an orchestrator key acts for when
+// confirming an object created at the given height, previous orchestrator keys remain
+// valid for objects created before the rotation away from them
+func (k Keeper) GetOrchestratorValidatorAt(ctx sdk.Context, orch sdk.AccAddress, height uint64) sdk.ValAddress {
+	if val := k.GetOrchestratorValidator(ctx, orch); val != nil {
+		return val
+	}
+	store := ctx.KVStore(k.storeKey)
+	val := sdk.ValAddress(store.Get(types.GetPreviousOrchestratorKey(orch)))
+	if val == nil {
+		return nil
+	}
+	if prev := k.GetPreviousDelegateKeys(ctx, val); prev != nil && height < prev.EffectiveHeight {
+		return val
+	}
+	return nil
+}
+
+// GetEthAddressAt returns the eth address a validator signs objects created at the
+// given height with, this is the previous eth address for objects created before the
+// last rotation took effect and the current one otherwise
+func (k Keeper) GetEthAddressAt(ctx sdk.Context, val sdk.ValAddress, height uint64) string {
+	if prev := k.GetPreviousDelegateKeys(ctx, val); prev != nil && height < prev.EffectiveHeight && prev.EthAddress != "" {
+		return prev.EthAddress
+	}
+	return k.GetEthAddress(ctx, val)
+}
+
+// ApplyDelegateKeyRotations switches every validator whose scheduled rotation is due to
+// its new keys, keeping the previous keys around until all objects created before the
+// switch are past their signing windows. It returns true if any validator changed its
+// ethereum address, in which case the bridge validator set has to be updated
+func (k Keeper) ApplyDelegateKeyRotations(ctx sdk.Context) (ethAddressChanged bool) {
+	height := uint64(ctx.BlockHeight())
+	params := k.GetParams(ctx)
+	window := params.SignedValsetsWindow
+	if params.SignedBatchesWindow > window {
+		window = params.SignedBatchesWindow
+	}
+
+	var expired []sdk.ValAddress
+	k.IteratePreviousDelegateKeys(ctx, func(keys *types.DelegateKeyRotation) bool {
+		if height > keys.EffectiveHeight+window {
+			val, _ := sdk.ValAddressFromBech32(keys.Validator)
+			expired = append(expired, val)
+		}
+		return false
+	})
+	for _, val := range expired {
+		k.deletePreviousDelegateKeys(ctx, val)
+	}
+
+	var due []*types.DelegateKeyRotation
+	k.IteratePendingDelegateKeyRotations(ctx, func(rotation *types.DelegateKeyRotation) bool {
+		if rotation.EffectiveHeight <= height {
+			due = append(due, rotation)
+		}
+		return false
+	})
+	for _, rotation := range due {
+		val, _ := sdk.ValAddressFromBech32(rotation.Validator)
+		orch, _ := sdk.AccAddressFromBech32(rotation.Orchestrator)
+
+		prevOrch := k.GetValidatorOrchestrator(ctx, val)
+		prevEthAddr := k.GetEthAddress(ctx, val)
+		previous := &types.DelegateKeyRotation{
+			Validator:       rotation.Validator,
+			EthAddress:      prevEthAddr,
+			EffectiveHeight: height,
+		}
+		if prevOrch != nil && !prevOrch.Equals(orch) {
+			previous.Orchestrator = prevOrch.String()
+		}
+		k.setPreviousDelegateKeys(ctx, val, previous)
+
+		k.SetOrchestratorValidator(ctx, val, orch)
+		k.SetEthAddress(ctx, val, rotation.EthAddress)
+		ctx.KVStore(k.storeKey).Delete(types.GetPendingDelegateKeyRotationKey(val))
+
+		if prevEthAddr != rotation.EthAddress {
+			ethAddressChanged = true
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegateKeyRotation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyValidator, rotation.Validator),
+				sdk.NewAttribute(types.AttributeKeyOrchestrator, rotation.Orchestrator),
+				sdk.NewAttribute(types.AttributeKeyEthAddress, rotation.EthAddress),
+				sdk.NewAttribute(types.AttributeKeyEffectiveHeight, fmt.Sprint(height)),
+			),
+		)
+	}
+	return ethAddressChanged
+}
+
+// deleteCurrentDelegateKeys removes the current delegate keys of a validator and their indexes
+func (k Keeper) deleteCurrentDelegateKeys(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if orch := k.GetValidatorOrchestrator(ctx, val); orch != nil {
+		store.Delete(types.GetOrchestratorAddressKey(orch))
+	}
+	if ethAddr := k.GetEthAddress(ctx, val); ethAddr != "" {
+		store.Delete(types.GetEthAddressValidatorKey(ethAddr))
+	}
+	store.Delete(types.GetValidatorOrchestratorKey(val))
+	store.Delete(types.GetEthAddressKey(val))
+}
+
+// deleteDelegateKeys removes all delegate keys of a validator, the delegate key nonce is
+// kept so that signatures over the validator address can not be replayed
+func (k Keeper) deleteDelegateKeys(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingDelegateKeyRotationKey(val))
+	k.deletePreviousDelegateKeys(ctx, val)
+	k.deleteCurrentDelegateKeys(ctx, val)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// SetDepositReceipt stores the receipt of a credited deposit and indexes it by receiver and
+// by Ethereum sender
+func (k Keeper) SetDepositReceipt(ctx sdk.Context, receipt *types.DepositReceipt) {
+	receiver, err := sdk.AccAddressFromBech32(receipt.CosmosReceiver)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDepositReceiptKey(receipt.EventNonce), k.cdc.MustMarshalBinaryBare(receipt))
+	store.Set(types.GetDepositReceiptByReceiverKey(receiver, receipt.EventNonce), []byte{})
+	store.Set(types.GetDepositReceiptBySenderKey(receipt.EthereumSender, receipt.EventNonce), []byte{})
+}
+
+// GetDepositReceipt returns the receipt of the deposit observed at the event nonce, if it is
+// still kept
+func (k Keeper) GetDepositReceipt(ctx sdk.Context, nonce uint64) *types.DepositReceipt {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDepositReceiptKey(nonce))
+	if bz == nil {
+		return nil
+	}
+	var receipt types.DepositReceipt
+	k.cdc.MustUnmarshalBinaryBare(bz, &receipt)
+	return &receipt
+}
+
+// deleteDepositReceipt deletes a deposit receipt along with its index entries
+func (k Keeper) deleteDepositReceipt(ctx sdk.Context, receipt *types.DepositReceipt) {
+	receiver, _ := sdk.AccAddressFromBech32(receipt.CosmosReceiver)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDepositReceiptKey(receipt.EventNonce))
+	store.Delete(types.GetDepositReceiptByReceiverKey(receiver, receipt.EventNonce))
+	store.Delete(types.GetDepositReceiptBySenderKey(receipt.EthereumSender, receipt.EventNonce))
+}
+
+// IterateDepositReceipts iterates through the deposit receipts by event nonce
+func (k Keeper) IterateDepositReceipts(ctx sdk.Context, cb func(*types.DepositReceipt) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyDepositReceipt)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var receipt types.DepositReceipt
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &receipt)
+		// cb returns true to stop early
+		if cb(&receipt) {
+			return
+		}
+	}
+}
+
+// PruneDepositReceipts removes the receipts of deposits credited more than the retention
+// period ago. Deposits are credited in event nonce order, so pruning stops at the first
+// receipt that is kept
+func (k Keeper) PruneDepositReceipts(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	retention := k.GetParams(ctx).DepositReceiptRetention
+
+	var expired []*types.DepositReceipt
+	k.IterateDepositReceipts(ctx, func(receipt *types.DepositReceipt) bool {
+		if height <= receipt.Height+retention {
+			return true
+		}
+		expired = append(expired, receipt)
+		return false
+	})
+	for _, receipt := range expired {
+		k.deleteDepositReceipt(ctx, receipt)
+	}
+}
+
+// paginateDepositReceipts returns a page of the receipts in an index keyed by event nonce
+func (k Keeper) paginateDepositReceipts(ctx sdk.Context, index []byte, pageReq *query.PageRequest) ([]types.DepositReceipt, *query.PageResponse, error) {
+	receipts := []types.DepositReceipt{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), index)
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(key, _ []byte) error {
+		if receipt := k.GetDepositReceipt(ctx, types.UInt64FromBytes(key)); receipt != nil {
+			receipts = append(receipts, *receipt)
+		}
+		return nil
+	})
+	return receipts, pageRes, err
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AttestERC20Metadata adds the vote of a validator to the attestation of the metadata of an ERC20
+// and applies the metadata once the votes pass the attestation threshold. The metadata is not an
+// event of the peggy contract, so it is attested by token contract and not by event nonce. A
+// validator has one vote per token contract, a vote for other metadata moves its earlier vote
+func (k Keeper) AttestERC20Metadata(ctx sdk.Context, val sdk.ValAddress, claim *types.MsgERC20MetadataClaim) (*types.ERC20MetadataAttestation, error) {
+	claimHash := claim.ClaimHash()
+	att := &types.ERC20MetadataAttestation{
+		TokenContract: claim.TokenContract,
+		Name:          claim.Name,
+		Symbol:        claim.Symbol,
+		Decimals:      claim.Decimals,
+		Height:        uint64(ctx.BlockHeight()),
+	}
+	var others []*types.ERC20MetadataAttestation
+	k.IterateERC20MetadataAttestations(ctx, claim.TokenContract, func(a *types.ERC20MetadataAttestation) bool {
+		if bytes.Equal(a.ClaimHash(), claimHash) {
+			att = a
+		} else {
+			others = append(others, a)
+		}
+		return false
+	})
+	if hasVote(att.Votes, val.String()) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "vote of %s for the metadata of %s", val, claim.TokenContract)
+	}
+
+	for _, other := range others {
+		if !hasVote(other.Votes, val.String()) {
+			continue
+		}
+		other.Votes = removeVote(other.Votes, val.String())
+		if len(other.Votes) == 0 {
+			k.deleteERC20MetadataAttestation(ctx, other)
+		} else {
+			k.SetERC20MetadataAttestation(ctx, other)
+		}
+	}
+
+	att.Votes = append(att.Votes, val.String())
+	k.SetERC20MetadataAttestation(ctx, att)
+	k.tryERC20MetadataAttestation(ctx, att)
+	return att, nil
+}
+
+// tryERC20MetadataAttestation applies the metadata of an attestation that is not observed yet once
+// the power of its votes passes the attestation threshold. The observed attestation replaces the
+// other attestations of the token contract
+func (k Keeper) tryERC20MetadataAttestation(ctx sdk.Context, att *types.ERC20MetadataAttestation) {
+	if att.Observed {
+		return
+	}
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
+	attestationPower := sdk.NewInt(0)
+	for _, validator := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
+		}
+		attestationPower = attestationPower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+	}
+	if attestationPower.LT(requiredPower) {
+		return
+	}
+
+	claimHash := att.ClaimHash()
+	var replaced []*types.ERC20MetadataAttestation
+	k.IterateERC20MetadataAttestations(ctx, att.TokenContract, func(a *types.ERC20MetadataAttestation) bool {
+		if !bytes.Equal(a.ClaimHash(), claimHash) {
+			replaced = append(replaced, a)
+		}
+		return false
+	})
+	for _, a := range replaced {
+		k.deleteERC20MetadataAttestation(ctx, a)
+	}
+	att.Observed = true
+	k.SetERC20MetadataAttestation(ctx, att)
+
+	// the metadata is applied in a cache context so a rejected metadata leaves no partial state
+	xCtx, commit := ctx.CacheContext()
+	if err := k.SetERC20Metadata(xCtx, att.TokenContract, att.Name, att.Symbol, att.Decimals); err != nil {
+		k.logger(ctx).Error("erc20 metadata attestation failed",
+			"cause", err.Error(),
+			"token contract", att.TokenContract,
+		)
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+}
+
+// SetERC20MetadataAttestation stores an attestation of ERC20 metadata
+func (k Keeper) SetERC20MetadataAttestation(ctx sdk.Context, att *types.ERC20MetadataAttestation) {
+	key := types.GetERC20MetadataAttestationKey(att.TokenContract, att.ClaimHash())
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(att))
+}
+
+// deleteERC20MetadataAttestation deletes an attestation of ERC20 metadata
+func (k Keeper) deleteERC20MetadataAttestation(ctx sdk.Context, att *types.ERC20MetadataAttestation) {
+	ctx.KVStore(k.storeKey).Delete(types.GetERC20MetadataAttestationKey(att.TokenContract, att.ClaimHash()))
+}
+
+// IterateERC20MetadataAttestations iterates through the attestations of the metadata of a token
+// contract, or through the attestations of all token contracts if it is empty
+func (k Keeper) IterateERC20MetadataAttestations(ctx sdk.Context, tokenContract string, cb func(*types.ERC20MetadataAttestation) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyERC20MetadataAttestation, []byte(tokenContract)...))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var att types.ERC20MetadataAttestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		// cb returns true to stop early
+		if cb(&att) {
+			return
+		}
+	}
+}
+
+func hasVote(votes []string, val string) bool {
+	for _, vote := range votes {
+		if vote == val {
+			return true
+		}
+	}
+	return false
+}
+
+func removeVote(votes []string, val string) []string {
+	out := make([]string, 0, len(votes))
+	for _, vote := range votes {
+		if vote != val {
+			out = append(out, vote)
+		}
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestERC20Metadata(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.PeggyKeeper
+	const tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	voucher := types.PeggyDenom(tokenContract)
+	dai := &types.MsgERC20MetadataClaim{TokenContract: tokenContract, Name: "Dai Stablecoin", Symbol: "DAI", Decimals: 18}
+	fake := &types.MsgERC20MetadataClaim{TokenContract: tokenContract, Name: "Fake", Symbol: "FAKE", Decimals: 6}
+	attestations := func() (out []*types.ERC20MetadataAttestation) {
+		k.IterateERC20MetadataAttestations(ctx, tokenContract, func(att *types.ERC20MetadataAttestation) bool {
+			out = append(out, att)
+			return false
+		})
+		return
+	}
+
+	// three of five validators are below the threshold
+	for _, val := range ValAddrs[:3] {
+		_, err := k.AttestERC20Metadata(ctx, val, dai)
+		require.NoError(t, err)
+	}
+	_, err := k.AttestERC20Metadata(ctx, ValAddrs[3], fake)
+	require.NoError(t, err)
+	require.Len(t, attestations(), 2)
+	assert.Empty(t, input.BankKeeper.GetDenomMetaData(ctx, voucher).Base)
+
+	// a validator votes once per metadata
+	_, err = k.AttestERC20Metadata(ctx, ValAddrs[0], dai)
+	assert.True(t, types.ErrDuplicate.Is(err), err)
+
+	// the vote of a validator moves to the other metadata, the attestation without votes is deleted
+	att, err := k.AttestERC20Metadata(ctx, ValAddrs[3], dai)
+	require.NoError(t, err)
+	assert.True(t, att.Observed)
+	assert.Len(t, att.Votes, 4)
+	assert.Equal(t, []*types.ERC20MetadataAttestation{att}, attestations())
+	assert.Equal(t, "DAI", input.BankKeeper.GetDenomMetaData(ctx, voucher).Display)
+
+	// late votes do not apply the metadata again and other metadata replaces it once observed
+	_, err = k.AttestERC20Metadata(ctx, ValAddrs[4], dai)
+	require.NoError(t, err)
+	for _, val := range ValAddrs[:3] {
+		_, err = k.AttestERC20Metadata(ctx, val, fake)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "DAI", input.BankKeeper.GetDenomMetaData(ctx, voucher).Display)
+	_, err = k.AttestERC20Metadata(ctx, ValAddrs[3], fake)
+	require.NoError(t, err)
+	assert.Equal(t, "FAKE", input.BankKeeper.GetDenomMetaData(ctx, voucher).Display)
+	require.Len(t, attestations(), 1)
+	assert.Equal(t, "FAKE", attestations()[0].Symbol)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+)
+
+// forwardDeposit starts an ICS-20 transfer of a deposit from the account holding it to the receiver
+// on the chain at the other end of the channel. The transfer runs in a cache context so a failure
+// leaves the deposit with the sender account instead of failing the attestation. The sender is the
+// fallback account of the Ethereum sender, which also receives the refund of a transfer that times
+// out or fails on the other chain, see SweepFallbackAccount.
+func (k Keeper) forwardDeposit(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, channel, receiver string) {
+	timeout := time.Duration(k.GetParams(ctx).IbcForwardTimeout) * time.Millisecond
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.transferKeeper.SendTransfer(
+		cacheCtx, ibctransfertypes.PortID, channel, coin, sender, receiver, clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+	if err != nil {
+		k.logger(ctx).Error("deposit forwarding failed", "channel", channel, "receiver", receiver, "error", err.Error())
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDepositForwardFailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, channel),
+			sdk.NewAttribute(types.AttributeKeyForwardReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyFallbackReceiver, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		))
+		return
+	}
+	writeCache()
+	// the cache context has its own event manager, relayers pick up the packet from its events
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositForwarded,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyForwardChannel, channel),
+		sdk.NewAttribute(types.AttributeKeyForwardReceiver, receiver),
+		sdk.NewAttribute(types.AttributeKeyFallbackReceiver, sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+	))
+}
+
+// SweepFallbackAccount moves all coins held by the fallback account of an Ethereum sender to the
+// receiver. No Cosmos key controls the fallback account, the Ethereum key has to sign over the
+// receiver and the current sweep nonce of the account instead
+func (k Keeper) SweepFallbackAccount(ctx sdk.Context, ethereumSender string, receiver sdk.AccAddress, ethSig string) (sdk.Coins, error) {
+	sigBytes, err := hex.DecodeString(ethSig)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+	ethereumSender = types.NormalizeEthAddress(ethereumSender)
+	nonce := k.GetFallbackSweepNonce(ctx, ethereumSender)
+	if err = types.ValidateEthereumSignature(types.GetFallbackSweepSignHash(k.GetPeggyID(ctx), receiver, nonce), sigBytes, ethereumSender); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s over receiver %s with nonce %d found %s", ethereumSender, receiver, nonce, ethSig))
+	}
+
+	account := types.EthereumSenderAccount(ethereumSender)
+	coins := k.bankKeeper.GetAllBalances(ctx, account)
+	if coins.IsZero() {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, fmt.Sprintf("fallback account %s", account))
+	}
+	if err := k.bankKeeper.SendCoins(ctx, account, receiver, coins); err != nil {
+		return nil, err
+	}
+	k.setFallbackSweepNonce(ctx, ethereumSender, nonce+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFallbackAccountSwept,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEthereumSender, ethereumSender),
+		sdk.NewAttribute(types.AttributeKeyFallbackReceiver, account.String()),
+		sdk.NewAttribute(types.AttributeKeyCosmosReceiver, receiver.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	))
+	return coins, nil
+}
+
+// GetFallbackSweepNonce returns the nonce the Ethereum key of a deposit sender has to sign
+// over the next time the coins held by its fallback account are swept
+func (k Keeper) GetFallbackSweepNonce(ctx sdk.Context, ethereumSender string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFallbackSweepNonceKey(ethereumSender))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+func (k Keeper) setFallbackSweepNonce(ctx sdk.Context, ethereumSender string, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetFallbackSweepNonceKey(ethereumSender), types.UInt64Bytes(nonce))
+}
+
+// IterateFallbackSweepNonces iterates through the sweep nonces of all fallback accounts
+func (k Keeper) IterateFallbackSweepNonces(ctx sdk.Context, cb func(ethereumSender string, nonce uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyFallbackSweepNonce)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(types.EthAddressFromBytes(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
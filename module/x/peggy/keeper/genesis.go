@@ -1,13 +1,29 @@
 package keeper
 
 import (
+	"github.com/althea-net/peggy/module/x/peggy/migrations"
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	// genesis is always imported in the current store layout
+	migrations.SetStoreVersion(ctx, k.storeKey, types.ConsensusVersion)
 	k.SetParams(ctx, *data.Params)
+
+	// reset the Cosmos originated denom mappings, approved ERC20 deployments and ERC20 metadata attestations
+	for _, m := range data.Erc20ToDenoms {
+		k.setCosmosOriginatedDenomToERC20(ctx, m.Denom, m.Erc20)
+	}
+	for i := range data.Erc20DeploymentRequests {
+		k.SetERC20DeploymentRequest(ctx, &data.Erc20DeploymentRequests[i])
+	}
+	for i := range data.Erc20MetadataAttestations {
+		k.SetERC20MetadataAttestation(ctx, &data.Erc20MetadataAttestations[i])
+	}
+
 	// reset valsets in state
 	for _, vs := range data.Valsets {
 		// TODO: block height?
@@ -25,8 +41,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.StoreBatchUnsafe(ctx, batch)
 	}
 
-	// reset logic calls in state
+	// reset logic calls in state, calls without a creation height are treated as created
+	// now so that their missing signatures are still checked
 	for _, call := range data.LogicCalls {
+		if call.Block == 0 {
+			call.Block = uint64(ctx.BlockHeight())
+			if call.Block <= data.LastSlashedLogicCallBlock {
+				call.Block = data.LastSlashedLogicCallBlock + 1
+			}
+		}
 		k.SetOutogingLogicCall(ctx, call)
 	}
 
@@ -77,7 +100,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 	// reset delegate keys in state
 	for _, keys := range data.DelegateKeys {
-		err := keys.ValidateBasic()
+		err := keys.ValidateKeys()
 		if err != nil {
 			panic("Invalid delegate key in Genesis!")
 		}
@@ -88,6 +111,92 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		// set the ethereum address
 		k.SetEthAddress(ctx, val, keys.EthAddress)
 	}
+	for _, n := range data.DelegateKeyNonces {
+		val, err := sdk.ValAddressFromBech32(n.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.setDelegateKeyNonce(ctx, val, n.Nonce)
+	}
+	for i := range data.PendingDelegateKeyRotations {
+		k.SetPendingDelegateKeyRotation(ctx, &data.PendingDelegateKeyRotations[i])
+	}
+	for i, keys := range data.PreviousDelegateKeys {
+		val, err := sdk.ValAddressFromBech32(keys.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.setPreviousDelegateKeys(ctx, val, &data.PreviousDelegateKeys[i])
+	}
+
+	// reset logic call confirmations in state, this must be done after the delegate
+	// keys are set since they are stored by the validator of the orchestrator
+	for i, conf := range data.LogicCallConfirms {
+		k.SetLogicCallConfirm(ctx, sdk.AccAddress(k.logicCallConfirmValidator(ctx, conf)), &data.LogicCallConfirms[i])
+	}
+
+	// reset the outgoing pool, transactions available for batching are added to the fee
+	// index in the order they were exported in
+	for i, entry := range data.OutgoingPool {
+		if err := k.setPoolEntry(ctx, entry.Id, &data.OutgoingPool[i].Tx); err != nil {
+			panic(err)
+		}
+	}
+	for _, id := range data.UnbatchedTxIds {
+		tx, err := k.getPoolEntry(ctx, id)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "unbatched tx %d", id))
+		}
+		_, tokenContract, err := k.DenomToERC20(ctx, tx.BridgeFee.Denom)
+		if err != nil {
+			panic(err)
+		}
+		k.appendToUnbatchedTXIndex(ctx, tokenContract, tx.BridgeFee, id)
+	}
+	for i := range data.TransferRecords {
+		k.setTransferRecord(ctx, &data.TransferRecords[i])
+	}
+	for i := range data.DepositReceipts {
+		k.SetDepositReceipt(ctx, &data.DepositReceipts[i])
+	}
+	for _, n := range data.FallbackSweepNonces {
+		k.setFallbackSweepNonce(ctx, n.EthereumSender, n.Nonce)
+	}
+	k.setLastID(ctx, types.KeyLastTXPoolID, data.LastTxPoolId)
+	k.setLastID(ctx, types.KeyLastOutgoingBatchID, data.LastOutgoingBatchId)
+
+	if data.LastObservedEthereumHeight != (types.LastObservedEthereumBlockHeight{}) {
+		k.setLastObservedEthereumBlockHeight(ctx, data.LastObservedEthereumHeight)
+	}
+	for i := range data.UnbondingValidators {
+		k.SetUnbondingValidator(ctx, &data.UnbondingValidators[i])
+	}
+	if data.LastSlashedLogicCallBlock != 0 {
+		k.SetLastSlashedLogicCallBlock(ctx, data.LastSlashedLogicCallBlock)
+	}
+	for _, n := range data.LogicCallNonces {
+		k.SetLastLogicCallNonce(ctx, n.Module, n.Nonce)
+	}
+}
+
+// logicCallConfirmValidator returns the validator a logic call confirmation was made for, the
+// orchestrator is resolved at the height the logic call was created as when it was confirmed
+func (k Keeper) logicCallConfirmValidator(ctx sdk.Context, conf types.MsgConfirmLogicCall) sdk.ValAddress {
+	orch, err := sdk.AccAddressFromBech32(conf.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	call := k.GetOutgoingLogicCall(ctx, conf.InvalidationId, conf.InvalidationNonce)
+	if call == nil {
+		panic(sdkerrors.Wrapf(types.ErrUnknown, "logic call %x %d", conf.InvalidationId, conf.InvalidationNonce))
+	}
+	if val := k.GetOrchestratorValidatorAt(ctx, orch, call.Block); val != nil {
+		return val
+	}
+	if val := k.StakingKeeper.Validator(ctx, sdk.ValAddress(orch)); val != nil {
+		return val.GetOperator()
+	}
+	panic(sdkerrors.Wrapf(types.ErrUnknown, "validator of orchestrator %s", conf.Orchestrator))
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -105,8 +214,82 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		attestations = []types.Attestation{}
 		delegates    = k.GetDelegateKeys(ctx)
 		lastobserved = k.GetLastObservedEventNonce(ctx)
+		pool         = []types.OutgoingPoolEntry{}
+		unbatched    = []uint64{}
+		records      = []types.TransferRecord{}
+		receipts     = []types.DepositReceipt{}
+		sweepnonces  = []types.FallbackSweepNonce{}
+		erc20s       = []types.ERC20ToDenom{}
+		deployments  = []types.ERC20DeploymentRequest{}
+		metadata     = []types.ERC20MetadataAttestation{}
+		keynonces    = []types.DelegateKeyNonce{}
+		pending      = []types.DelegateKeyRotation{}
+		previous     = []types.DelegateKeyRotation{}
+		unbonding    = []types.UnbondingValidator{}
+		callnonces   = []types.LogicCallNonce{}
 	)
 
+	// export the outgoing pool and the transactions available for batching in fee index order
+	k.IterateOutgoingPool(ctx, func(id uint64, tx *types.OutgoingTx) bool {
+		pool = append(pool, types.OutgoingPoolEntry{Id: id, Tx: *tx})
+		return false
+	})
+	k.IterateOutgoingPoolFeeIndex(ctx, func(ids []uint64) bool {
+		unbatched = append(unbatched, ids...)
+		return false
+	})
+	k.IterateTransferRecords(ctx, func(record *types.TransferRecord) bool {
+		records = append(records, *record)
+		return false
+	})
+	k.IterateDepositReceipts(ctx, func(receipt *types.DepositReceipt) bool {
+		receipts = append(receipts, *receipt)
+		return false
+	})
+	k.IterateFallbackSweepNonces(ctx, func(ethereumSender string, nonce uint64) bool {
+		sweepnonces = append(sweepnonces, types.FallbackSweepNonce{EthereumSender: ethereumSender, Nonce: nonce})
+		return false
+	})
+
+	// export the Cosmos originated denom mappings, approved ERC20 deployments and ERC20 metadata attestations
+	k.IterateCosmosOriginatedDenoms(ctx, func(denom, tokenContract string) bool {
+		erc20s = append(erc20s, types.ERC20ToDenom{Erc20: tokenContract, Denom: denom})
+		return false
+	})
+	k.IterateERC20DeploymentRequests(ctx, func(req *types.ERC20DeploymentRequest) bool {
+		deployments = append(deployments, *req)
+		return false
+	})
+	k.IterateERC20MetadataAttestations(ctx, "", func(att *types.ERC20MetadataAttestation) bool {
+		metadata = append(metadata, *att)
+		return false
+	})
+
+	// export the delegate key nonces and rotations
+	k.IterateDelegateKeyNonces(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		keynonces = append(keynonces, types.DelegateKeyNonce{Validator: val.String(), Nonce: nonce})
+		return false
+	})
+	k.IteratePendingDelegateKeyRotations(ctx, func(rotation *types.DelegateKeyRotation) bool {
+		pending = append(pending, *rotation)
+		return false
+	})
+	k.IteratePreviousDelegateKeys(ctx, func(keys *types.DelegateKeyRotation) bool {
+		previous = append(previous, *keys)
+		return false
+	})
+
+	// export the validators still liable after unbonding
+	k.IterateUnbondingValidators(ctx, func(uv *types.UnbondingValidator) bool {
+		unbonding = append(unbonding, *uv)
+		return false
+	})
+
+	k.IterateLastLogicCallNonces(ctx, func(module string, nonce uint64) bool {
+		callnonces = append(callnonces, types.LogicCallNonce{Module: module, Nonce: nonce})
+		return false
+	})
+
 	// export valset confirmations from state
 	for _, vs := range valsets {
 		// TODO: set height = 0?
@@ -142,5 +325,23 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		LogicCallConfirms: callconfs,
 		Attestations:      attestations,
 		DelegateKeys:      delegates,
+
+		OutgoingPool:                pool,
+		UnbatchedTxIds:              unbatched,
+		TransferRecords:             records,
+		DepositReceipts:             receipts,
+		FallbackSweepNonces:         sweepnonces,
+		LastTxPoolId:                k.getLastID(ctx, types.KeyLastTXPoolID),
+		LastOutgoingBatchId:         k.getLastID(ctx, types.KeyLastOutgoingBatchID),
+		Erc20ToDenoms:               erc20s,
+		LastObservedEthereumHeight:  k.GetLastObservedEthereumBlockHeight(ctx),
+		DelegateKeyNonces:           keynonces,
+		PendingDelegateKeyRotations: pending,
+		PreviousDelegateKeys:        previous,
+		UnbondingValidators:         unbonding,
+		LastSlashedLogicCallBlock:   k.GetLastSlashedLogicCallBlock(ctx),
+		LogicCallNonces:             callnonces,
+		Erc20DeploymentRequests:     deployments,
+		Erc20MetadataAttestations:   metadata,
 	}
 }
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type kvPair struct {
+	Key, Value []byte
+}
+
+func storeContents(ctx sdk.Context, k Keeper) (out []kvPair) {
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, kvPair{Key: iter.Key(), Value: iter.Value()})
+	}
+	return out
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		mySender          = AccAddrs[0]
+		myReceiver        = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		stakeContractAddr = "0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"
+		tokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		logicContractAddr = "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+		orchAddrs         = AccAddrs[1:4]
+		signature         = strings.Repeat("ab", 65)
+	)
+
+	// delegate keys, a pending rotation and keys rotated away from
+	for i, orch := range orchAddrs {
+		k.SetOrchestratorValidator(ctx, ValAddrs[i], orch)
+		k.SetEthAddress(ctx, ValAddrs[i], EthAddrs[i].String())
+		k.incrementDelegateKeyNonce(ctx, ValAddrs[i])
+	}
+	k.SetPendingDelegateKeyRotation(ctx, &types.DelegateKeyRotation{
+		Validator:       ValAddrs[0].String(),
+		Orchestrator:    AccAddrs[4].String(),
+		EthAddress:      EthAddrs[4].String(),
+		EffectiveHeight: uint64(ctx.BlockHeight()) + 10,
+	})
+	k.setPreviousDelegateKeys(ctx, ValAddrs[1], &types.DelegateKeyRotation{
+		Validator:       ValAddrs[1].String(),
+		Orchestrator:    AccAddrs[0].String(),
+		EthAddress:      myReceiver,
+		EffectiveHeight: uint64(ctx.BlockHeight()) - 10,
+	})
+
+	// token mappings and the outgoing pool with a batched, a canceled and an executed batch
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", stakeContractAddr)
+	k.SetERC20DeploymentRequest(ctx, &types.ERC20DeploymentRequest{Denom: "uatom", Name: "Atom", Symbol: "ATOM", Decimals: 6})
+	k.SetERC20MetadataAttestation(ctx, &types.ERC20MetadataAttestation{TokenContract: tokenContractAddr, Name: "Max", Symbol: "MAX", Decimals: 18, Votes: []string{ValAddrs[0].String()}, Height: 1})
+	voucher := MintVouchersFromAir(t, ctx, k, mySender, types.ERC20Token{Contract: tokenContractAddr, Amount: sdk.NewInt(1000)})
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.AddCoins(ctx, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	for _, fee := range []int64{1, 2, 2, 3, 3} {
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("stake", fee))
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(ctx, mySender, myReceiver, sdk.NewInt64Coin(voucher.Denom, 100), sdk.NewInt64Coin(voucher.Denom, fee))
+		require.NoError(t, err)
+	}
+	canceled, err := k.BuildOutgoingTXBatch(ctx, stakeContractAddr, 3)
+	require.NoError(t, err)
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, stakeContractAddr, canceled.BatchNonce))
+	executed, err := k.BuildOutgoingTXBatch(ctx, stakeContractAddr, 2)
+	require.NoError(t, err)
+	require.NoError(t, k.OutgoingTxBatchExecuted(ctx, stakeContractAddr, executed.BatchNonce, 1))
+	batch, err := k.BuildOutgoingTXBatch(ctx, tokenContractAddr, 2)
+	require.NoError(t, err)
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: tokenContractAddr,
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  orchAddrs[0].String(),
+		Signature:     signature,
+	})
+
+	// a logic call with a confirmation
+	call, err := k.CreateLogicCall(ctx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), nil, logicContractAddr, nil, 500)
+	require.NoError(t, err)
+	k.SetLogicCallConfirm(ctx, sdk.AccAddress(ValAddrs[1]), &types.MsgConfirmLogicCall{
+		InvalidationId:    call.InvalidationId,
+		InvalidationNonce: call.InvalidationNonce,
+		EthSigner:         EthAddrs[1].String(),
+		Orchestrator:      orchAddrs[1].String(),
+		Signature:         signature,
+	})
+
+	// a valset with a confirmation and an attestation
+	valset := k.SetValsetRequest(ctx)
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: orchAddrs[2].String(),
+		EthAddress:   EthAddrs[2].String(),
+		Signature:    signature,
+	})
+	claim := &types.MsgDepositClaim{EventNonce: 1, TokenContract: tokenContractAddr, Amount: sdk.NewInt(5), EthereumSender: myReceiver, CosmosReceiver: mySender.String(), Orchestrator: orchAddrs[0].String()}
+	k.SetAttestation(ctx, claim.EventNonce, claim.ClaimHash(), &types.Attestation{Observed: true, Votes: []string{ValAddrs[0].String()}, Claim: mustPackClaim(t, claim)})
+	k.SetDepositReceipt(ctx, &types.DepositReceipt{EventNonce: 1, EthereumHeight: 90, EthereumSender: myReceiver, CosmosReceiver: mySender.String(), TokenContract: tokenContractAddr, Denom: voucher.Denom, Amount: sdk.NewInt(5), Height: 10})
+	k.setFallbackSweepNonce(ctx, myReceiver, 2)
+	k.setLastEventNonceByValidator(ctx, ValAddrs[0], 1)
+	k.setLastObservedEventNonce(ctx, 1)
+	k.SetLastObservedEthereumBlockHeight(ctx, 100)
+
+	// validator accounting
+	k.SetUnbondingValidator(ctx, &types.UnbondingValidator{Validator: ValAddrs[3].String(), UnbondingHeight: 20, Power: 7})
+	k.SetLastSlashedLogicCallBlock(ctx, 30)
+
+	// when
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, genesis.ValidateBasic())
+	assert.Len(t, genesis.TransferRecords, 2)
+	assert.Len(t, genesis.DepositReceipts, 1)
+	assert.Len(t, genesis.FallbackSweepNonces, 1)
+	restored := CreateTestEnv(t)
+	InitGenesis(restored.Context, restored.PeggyKeeper, genesis)
+
+	// then
+	assert.Equal(t, storeContents(ctx, k), storeContents(restored.Context, restored.PeggyKeeper))
+	assert.Equal(t, genesis, ExportGenesis(restored.Context, restored.PeggyKeeper))
+}
+
+func TestInitGenesisLogicCallWithoutBlock(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context.WithBlockHeight(5)
+	k := input.PeggyKeeper
+	genesis := types.DefaultGenesisState()
+	genesis.LastSlashedLogicCallBlock = 30
+	genesis.LogicCalls = []*types.OutgoingLogicCall{
+		{InvalidationId: []byte{1}, InvalidationNonce: 1},
+		{InvalidationId: []byte{2}, InvalidationNonce: 1, Block: 40},
+	}
+
+	// when
+	InitGenesis(ctx, k, *genesis)
+
+	// then calls without a block are still checked for missing signatures
+	assert.Equal(t, uint64(31), k.GetOutgoingLogicCall(ctx, []byte{1}, 1).Block)
+	assert.Equal(t, uint64(40), k.GetOutgoingLogicCall(ctx, []byte{2}, 1).Block)
+}
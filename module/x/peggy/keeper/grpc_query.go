@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -40,24 +43,41 @@ func (k Keeper) ValsetConfirm(c context.Context, req *types.QueryValsetConfirmRe
 // ValsetConfirmsByNonce queries the ValsetConfirmsByNonce of the peggy module
 func (k Keeper) ValsetConfirmsByNonce(c context.Context, req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
 	var confirms []*types.MsgValsetConfirm
-	k.IterateValsetConfirmByNonce(sdk.UnwrapSDKContext(c), req.Nonce, func(_ []byte, c types.MsgValsetConfirm) bool {
-		confirms = append(confirms, &c)
-		return false
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), append(types.ValsetConfirmKey, types.UInt64Bytes(req.Nonce)...))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var confirm types.MsgValsetConfirm
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
 	})
-	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
-// LastValsetRequests queries the LastValsetRequests of the peggy module
+// LastValsetRequests queries the LastValsetRequests of the peggy module, newest first
 func (k Keeper) LastValsetRequests(c context.Context, req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
-	valReqLen := len(valReq)
-	retLen := 0
-	if valReqLen < maxValsetRequestsReturned {
-		retLen = valReqLen
-	} else {
-		retLen = maxValsetRequestsReturned
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: maxValsetRequestsReturned}
+	}
+	var valsets []*types.Valset
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.ValsetRequestKey)
+	pageRes, err := query.Paginate(reverseStore{prefixStore}, pageReq, func(_, value []byte) error {
+		var valset types.Valset
+		if err := k.cdc.UnmarshalBinaryBare(value, &valset); err != nil {
+			return err
+		}
+		valsets = append(valsets, &valset)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	return &types.QueryLastValsetRequestsResponse{Valsets: valReq[0:retLen]}, nil
+	return &types.QueryLastValsetRequestsResponse{Valsets: valsets, Pagination: pageRes}, nil
 }
 
 // LastPendingValsetRequestByAddr queries the LastPendingValsetRequestByAddr of the peggy module
@@ -89,7 +109,17 @@ func (k Keeper) LastPendingValsetRequestByAddr(c context.Context, req *types.Que
 
 // BatchFees queries the batch fees from unbatched pool
 func (k Keeper) BatchFees(c context.Context, req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.CreateBatchFees(sdk.UnwrapSDKContext(c))}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	var batchFees []*types.BatchFees
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	pageRes, err := query.Paginate(tokenContractStore{prefixStore}, req.Pagination, func(key, _ []byte) error {
+		batchFees = append(batchFees, k.getBatchFees(ctx, key[:gethcommon.AddressLength]))
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryBatchFeeResponse{BatchFees: batchFees, Pagination: pageRes}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the peggy module
@@ -133,21 +163,37 @@ func (k Keeper) LastPendingLogicCallByAddr(c context.Context, req *types.QueryLa
 // OutgoingTxBatches queries the OutgoingTxBatches of the peggy module
 func (k Keeper) OutgoingTxBatches(c context.Context, req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
 	var batches []*types.OutgoingTxBatch
-	k.IterateOutgoingTXBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch *types.OutgoingTxBatch) bool {
-		batches = append(batches, batch)
-		return len(batches) == MaxResults
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.OutgoingTXBatchKey)
+	pageRes, err := query.Paginate(reverseStore{prefixStore}, req.Pagination, func(_, value []byte) error {
+		var batch types.OutgoingTxBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return err
+		}
+		batches = append(batches, &batch)
+		return nil
 	})
-	return &types.QueryOutgoingTxBatchesResponse{Batches: batches}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOutgoingTxBatchesResponse{Batches: batches, Pagination: pageRes}, nil
 }
 
 // OutgoingLogicCalls queries the OutgoingLogicCalls of the peggy module
 func (k Keeper) OutgoingLogicCalls(c context.Context, req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
 	var calls []*types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call *types.OutgoingLogicCall) bool {
-		calls = append(calls, call)
-		return len(calls) == MaxResults
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.KeyOutgoingLogicCall)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var call types.OutgoingLogicCall
+		if err := k.cdc.UnmarshalBinaryBare(value, &call); err != nil {
+			return err
+		}
+		calls = append(calls, &call)
+		return nil
 	})
-	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryOutgoingLogicCallsResponse{Calls: calls, Pagination: pageRes}, nil
 }
 
 // BatchRequestByNonce queries the BatchRequestByNonce of the peggy module
@@ -165,21 +211,39 @@ func (k Keeper) BatchRequestByNonce(c context.Context, req *types.QueryBatchRequ
 // BatchConfirms returns the batch confirmations by nonce and token contract
 func (k Keeper) BatchConfirms(c context.Context, req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
 	var confirms []*types.MsgConfirmBatch
-	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c), req.Nonce, req.ContractAddress, func(_ []byte, c types.MsgConfirmBatch) bool {
-		confirms = append(confirms, &c)
-		return false
+	keyPrefix := append(types.EthAddressBytes(req.ContractAddress), types.UInt64Bytes(req.Nonce)...)
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), append(types.BatchConfirmKey, keyPrefix...))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var confirm types.MsgConfirmBatch
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
 	})
-	return &types.QueryBatchConfirmsResponse{Confirms: confirms}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryBatchConfirmsResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
 // LogicConfirms returns the Logic confirmations by nonce and token contract
 func (k Keeper) LogicConfirms(c context.Context, req *types.QueryLogicConfirmsRequest) (*types.QueryLogicConfirmsResponse, error) {
 	var confirms []*types.MsgConfirmLogicCall
-	k.IterateLogicConfirmByInvalidationIdAndNonce(sdk.UnwrapSDKContext(c), req.InvalidationId, req.InvalidationNonce, func(_ []byte, c *types.MsgConfirmLogicCall) bool {
-		confirms = append(confirms, c)
-		return false
+	keyPrefix := append(append([]byte{}, req.InvalidationId...), types.UInt64Bytes(req.InvalidationNonce)...)
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), append(types.KeyOutgoingLogicConfirm, keyPrefix...))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var confirm types.MsgConfirmLogicCall
+		if err := k.cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return err
+		}
+		confirms = append(confirms, &confirm)
+		return nil
 	})
-	return &types.QueryLogicConfirmsResponse{Confirms: confirms}, nil
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryLogicConfirmsResponse{Confirms: confirms, Pagination: pageRes}, nil
 }
 
 // LastEventNonceByAddr returns the last event nonce for the given validator address, this allows eth oracles to figure out where they left off
@@ -198,3 +262,252 @@ func (k Keeper) LastEventNonceByAddr(c context.Context, req *types.QueryLastEven
 	ret.EventNonce = lastEventNonce
 	return &ret, nil
 }
+
+// PendingDelegateKeyRotations returns the delegate key rotations that have not taken effect yet,
+// optionally only the one scheduled for the given validator
+func (k Keeper) PendingDelegateKeyRotations(c context.Context, req *types.QueryPendingDelegateKeyRotationsRequest) (*types.QueryPendingDelegateKeyRotationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Validator == "" {
+		return &types.QueryPendingDelegateKeyRotationsResponse{Rotations: k.GetPendingDelegateKeyRotations(ctx)}, nil
+	}
+	val, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Validator)
+	}
+	var rotations []*types.DelegateKeyRotation
+	if rotation := k.GetPendingDelegateKeyRotation(ctx, val); rotation != nil {
+		rotations = append(rotations, rotation)
+	}
+	return &types.QueryPendingDelegateKeyRotationsResponse{Rotations: rotations}, nil
+}
+
+// DelegateKeysByValidator returns the orchestrator and eth address the given validator delegated to
+func (k Keeper) DelegateKeysByValidator(c context.Context, req *types.QueryDelegateKeysByValidatorRequest) (*types.QueryDelegateKeysByValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+	ethAddress := k.GetEthAddress(ctx, val)
+	orch := k.GetValidatorOrchestrator(ctx, val)
+	if ethAddress == "" && orch == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+	res := &types.QueryDelegateKeysByValidatorResponse{EthAddress: ethAddress}
+	if orch != nil {
+		res.OrchestratorAddress = orch.String()
+	}
+	return res, nil
+}
+
+// DelegateKeysByOrchestrator returns the validator the given orchestrator acts for and its eth address
+func (k Keeper) DelegateKeysByOrchestrator(c context.Context, req *types.QueryDelegateKeysByOrchestratorRequest) (*types.QueryDelegateKeysByOrchestratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	orch, err := sdk.AccAddressFromBech32(req.OrchestratorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.OrchestratorAddress)
+	}
+	val := k.GetOrchestratorValidator(ctx, orch)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "orchestrator")
+	}
+	return &types.QueryDelegateKeysByOrchestratorResponse{
+		ValidatorAddress: val.String(),
+		EthAddress:       k.GetEthAddress(ctx, val),
+	}, nil
+}
+
+// DelegateKeysByEthAddress returns the validator the given eth address signs for and its orchestrator
+func (k Keeper) DelegateKeysByEthAddress(c context.Context, req *types.QueryDelegateKeysByEthAddressRequest) (*types.QueryDelegateKeysByEthAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := types.ValidateEthAddress(req.EthAddress); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	val := k.GetEthAddressValidator(ctx, req.EthAddress)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "eth address")
+	}
+	res := &types.QueryDelegateKeysByEthAddressResponse{ValidatorAddress: val.String()}
+	if orch := k.GetValidatorOrchestrator(ctx, val); orch != nil {
+		res.OrchestratorAddress = orch.String()
+	}
+	return res, nil
+}
+
+// DelegateKeyNonce returns the nonce the Ethereum key of the next delegate keys of the given validator has to sign
+func (k Keeper) DelegateKeyNonce(c context.Context, req *types.QueryDelegateKeyNonceRequest) (*types.QueryDelegateKeyNonceResponse, error) {
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+	return &types.QueryDelegateKeyNonceResponse{Nonce: k.GetDelegateKeyNonce(sdk.UnwrapSDKContext(c), val)}, nil
+}
+
+// ValidatorObligations returns the valsets, batches and logic calls a validator is liable for and has not signed,
+// this includes validators that are unbonding and still responsible for what was created while they were bonded
+func (k Keeper) ValidatorObligations(c context.Context, req *types.QueryValidatorObligationsRequest) (*types.QueryValidatorObligationsResponse, error) {
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+	valsets, batches, calls := k.GetValidatorObligations(sdk.UnwrapSDKContext(c), val)
+	return &types.QueryValidatorObligationsResponse{Valsets: valsets, Batches: batches, LogicCalls: calls}, nil
+}
+
+// TokenMetadata returns the denom an ERC20 is bridged to along with its bank metadata
+func (k Keeper) TokenMetadata(c context.Context, req *types.QueryTokenMetadataRequest) (*types.QueryTokenMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+		return nil, sdkerrors.Wrap(err, "token contract")
+	}
+	_, denom := k.ERC20ToDenom(ctx, req.TokenContract)
+	attestations := []types.ERC20MetadataAttestation{}
+	k.IterateERC20MetadataAttestations(ctx, types.NormalizeEthAddress(req.TokenContract), func(att *types.ERC20MetadataAttestation) bool {
+		attestations = append(attestations, *att)
+		return false
+	})
+	return &types.QueryTokenMetadataResponse{
+		Denom:        denom,
+		Metadata:     k.bankKeeper.GetDenomMetaData(ctx, denom),
+		Attestations: attestations,
+	}, nil
+}
+
+// BridgeStatus summarizes the health of the bridge
+func (k Keeper) BridgeStatus(c context.Context, req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	return &types.QueryBridgeStatusResponse{Status: k.GetBridgeStatus(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// TransferStatus returns the stage of a transfer to Ethereum
+func (k Keeper) TransferStatus(c context.Context, req *types.QueryTransferStatusRequest) (*types.QueryTransferStatusResponse, error) {
+	return k.GetTransferStatus(sdk.UnwrapSDKContext(c), req.TxId)
+}
+
+// DepositReceiptsByReceiver returns a page of the receipts of the deposits credited to an account by event nonce
+func (k Keeper) DepositReceiptsByReceiver(c context.Context, req *types.QueryDepositReceiptsByReceiverRequest) (*types.QueryDepositReceiptsResponse, error) {
+	receiver, err := sdk.AccAddressFromBech32(req.CosmosReceiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+	}
+	index := append(types.KeyDepositReceiptByReceiver, receiver.Bytes()...)
+	receipts, pageRes, err := k.paginateDepositReceipts(sdk.UnwrapSDKContext(c), index, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryDepositReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
+
+// DepositReceiptsBySender returns a page of the receipts of the deposits of an Ethereum sender by event nonce
+func (k Keeper) DepositReceiptsBySender(c context.Context, req *types.QueryDepositReceiptsBySenderRequest) (*types.QueryDepositReceiptsResponse, error) {
+	if err := types.ValidateEthAddress(req.EthereumSender); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	index := append(types.KeyDepositReceiptBySender, types.EthAddressBytes(req.EthereumSender)...)
+	receipts, pageRes, err := k.paginateDepositReceipts(sdk.UnwrapSDKContext(c), index, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryDepositReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
+
+// FallbackAccount returns the fallback account of an Ethereum sender with its balance and sweep nonce
+func (k Keeper) FallbackAccount(c context.Context, req *types.QueryFallbackAccountRequest) (*types.QueryFallbackAccountResponse, error) {
+	if err := types.ValidateEthAddress(req.EthereumSender); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	account := types.EthereumSenderAccount(req.EthereumSender)
+	return &types.QueryFallbackAccountResponse{
+		Account:    account.String(),
+		Balance:    k.bankKeeper.GetAllBalances(ctx, account),
+		SweepNonce: k.GetFallbackSweepNonce(ctx, req.EthereumSender),
+	}, nil
+}
+
+// Attestations returns a page of the attestations by event nonce that match the filters of the request
+func (k Keeper) Attestations(c context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	var attestations []types.AttestationEntry
+	prefixStore := prefix.NewStore(sdk.UnwrapSDKContext(c).KVStore(k.storeKey), types.OracleAttestationKey)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var att types.Attestation
+		if err := k.cdc.UnmarshalBinaryBare(value, &att); err != nil {
+			return false, err
+		}
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			return false, err
+		}
+		if !matchAttestation(req, &att, claim) {
+			return false, nil
+		}
+		if accumulate {
+			attestations = append(attestations, types.AttestationEntry{ClaimHash: key[len(types.UInt64Bytes(0)):], Attestation: att})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+// matchAttestation checks an attestation against the filters of an attestations request
+func matchAttestation(req *types.QueryAttestationsRequest, att *types.Attestation, claim types.EthereumClaim) bool {
+	switch {
+	case req.Observed == types.OBSERVATION_FILTER_OBSERVED && !att.Observed,
+		req.Observed == types.OBSERVATION_FILTER_PENDING && att.Observed,
+		req.ClaimType != types.CLAIM_TYPE_UNKNOWN && req.ClaimType != claim.GetType(),
+		att.Height < req.MinHeight,
+		req.MaxHeight != 0 && att.Height > req.MaxHeight:
+		return false
+	}
+	return true
+}
+
+// AttestationsByNonce returns the attestations of every claim made for an event nonce
+func (k Keeper) AttestationsByNonce(c context.Context, req *types.QueryAttestationsByNonceRequest) (*types.QueryAttestationsByNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var attestations []types.AttestationEntry
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.OracleAttestationKey, types.UInt64Bytes(req.EventNonce)...))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &att)
+		attestations = append(attestations, types.AttestationEntry{ClaimHash: iter.Key(), Attestation: att})
+	}
+	return &types.QueryAttestationsByNonceResponse{Attestations: attestations}, nil
+}
+
+// AttestationVotes returns the voters of an attestation with their current power and how far the
+// votes are from the power needed to observe the attestation
+func (k Keeper) AttestationVotes(c context.Context, req *types.QueryAttestationVotesRequest) (*types.QueryAttestationVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	att := k.GetAttestation(ctx, req.EventNonce, req.ClaimHash)
+	if att == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "attestation")
+	}
+
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	res := &types.QueryAttestationVotesResponse{
+		Observed:            att.Observed,
+		VotedPower:          sdk.ZeroInt(),
+		TotalPower:          totalPower,
+		RequiredPower:       types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100)),
+		VotedPercentage:     sdk.ZeroDec(),
+		ThresholdPercentage: types.AttestationVotesPowerThreshold,
+	}
+	for _, voter := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(voter)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "voter")
+		}
+		power := k.StakingKeeper.GetLastValidatorPower(ctx, val)
+		res.Votes = append(res.Votes, types.AttestationVote{Validator: voter, Power: power})
+		res.VotedPower = res.VotedPower.Add(sdk.NewInt(power))
+	}
+	if totalPower.IsPositive() {
+		res.VotedPercentage = res.VotedPower.ToDec().MulInt64(100).QuoInt(totalPower)
+	}
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks is a wrapper struct around Keeper that implements the staking hooks
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the peggy module, registering them lets the
+// bridge react to changes of the validator set as they happen instead of waiting
+// for the power change check in the EndBlocker
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// requestValsetUpdate stores a new valset if the bonded validators differ from
+// the latest valset, several validators leaving in the same block overwrite the
+// valset stored for that height so only the final set has to be signed
+func (h Hooks) requestValsetUpdate(ctx sdk.Context) {
+	valsets := h.k.GetValsets(ctx)
+	if len(valsets) > 0 && types.BridgeValidators(h.k.GetCurrentValset(ctx).Members).PowerDiff(valsets[0].Members) == 0 {
+		return
+	}
+	h.k.SetValsetRequest(ctx)
+}
+
+// AfterValidatorBeginUnbonding removes the validator from the bridge validator set. The
+// validator stays liable for signing everything created while it was bonded. Its delegate
+// keys are kept, the hook also runs when a validator is jailed and the validator signs with
+// the same keys once it is unjailed
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	// the last power is only deleted by the staking module after this hook
+	h.k.SetUnbondingValidator(ctx, &types.UnbondingValidator{
+		Validator:       valAddr.String(),
+		UnbondingHeight: uint64(ctx.BlockHeight()),
+		Power:           h.k.StakingKeeper.GetLastValidatorPower(ctx, valAddr),
+	})
+	h.requestValsetUpdate(ctx)
+}
+
+// AfterValidatorRemoved deletes the delegate keys of a validator that no longer exists
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.DeleteUnbondingValidator(ctx, valAddr)
+	h.k.deleteDelegateKeys(ctx, valAddr)
+	h.requestValsetUpdate(ctx)
+}
+
+// AfterValidatorBonded drops the unbonding record of a validator that is bonded again,
+// it is liable for signing as part of the bonded set
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.DeleteUnbondingValidator(ctx, valAddr)
+}
+
+// BeforeValidatorSlashed emits the slash for bridge accounting, the bonded power behind
+// the bridge validator set shrinks without a change in its membership
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSlashed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+		),
+	)
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                            {}
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooksValidatorBeginUnbonding(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.PeggyKeeper
+	val, orch := ValAddrs[0], AccAddrs[0]
+	k.SetOrchestratorValidator(ctx, val, orch)
+
+	ctx = ctx.WithBlockHeight(10)
+	k.SetValsetRequest(ctx)
+	require.Len(t, k.GetValsets(ctx)[0].Members, 5)
+
+	// jailing the validator makes it begin unbonding in the staking EndBlocker
+	ctx = ctx.WithBlockHeight(20)
+	input.StakingKeeper.Jail(ctx, sdk.ConsAddress(ConsPubKeys[0].Address()))
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// a valset without the validator is requested right away
+	valsets := k.GetValsets(ctx)
+	require.Len(t, valsets, 2)
+	assert.Equal(t, uint64(20), valsets[0].Height)
+	assert.Len(t, valsets[0].Members, 4)
+
+	// a jailed validator keeps its keys, it signs with them again once unjailed
+	assert.Equal(t, EthAddrs[0].String(), k.GetEthAddress(ctx, val))
+	assert.Equal(t, val, k.GetOrchestratorValidator(ctx, orch))
+	assert.Equal(t, val, k.GetEthAddressValidator(ctx, EthAddrs[0].String()))
+	assert.Equal(t, EthAddrs[0].String(), k.GetEthAddressAt(ctx, val, 10))
+	assert.Equal(t, val, k.GetOrchestratorValidatorAt(ctx, orch, 20))
+
+	// once the validator is removed nothing is left
+	k.Hooks().AfterValidatorRemoved(ctx, sdk.ConsAddress(ConsPubKeys[0].Address()), val)
+	assert.Empty(t, k.GetEthAddress(ctx, val))
+	assert.Nil(t, k.GetOrchestratorValidator(ctx, orch))
+	assert.Nil(t, k.GetEthAddressValidator(ctx, EthAddrs[0].String()))
+	assert.Nil(t, k.GetPreviousDelegateKeys(ctx, val))
+	assert.Nil(t, k.GetOrchestratorValidatorAt(ctx, orch, 10))
+	assert.Len(t, k.GetValsets(ctx), 2)
+}
+
+func TestHooksValidatorSlashed(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	cons := sdk.ConsAddress(ConsPubKeys[1].Address())
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+
+	fraction := sdk.NewDecWithPrec(1, 1)
+	before := storeContents(ctx, input.PeggyKeeper)
+	input.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[1]), fraction)
+
+	// then
+	var slashed []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeValidatorSlashed {
+			slashed = append(slashed, e)
+		}
+	}
+	require.Len(t, slashed, 1)
+	attrs := make(map[string]string)
+	for _, a := range slashed[0].Attributes {
+		attrs[string(a.Key)] = string(a.Value)
+	}
+	assert.Equal(t, ValAddrs[1].String(), attrs[types.AttributeKeyValidator])
+	assert.Equal(t, fraction.String(), attrs[types.AttributeKeySlashFraction])
+	// nothing is kept in the store
+	assert.Equal(t, before, storeContents(ctx, input.PeggyKeeper))
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers all peggy invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "outgoing-pool", OutgoingPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "observed-nonce", ObservedNonceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the peggy module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ModuleBalanceInvariant(k),
+			OutgoingPoolInvariant(k),
+			ObservedNonceInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds the Cosmos originated coins locked in the
+// unbatched pool, the unexecuted batches and the logic calls. Coins of executed batches and logic calls
+// stay locked to back the ERC20 supply on Ethereum, so the balance may exceed what is locked.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		cosmosOriginated := make(map[string]string)
+		k.IterateCosmosOriginatedDenoms(ctx, func(denom, tokenContract string) bool {
+			cosmosOriginated[tokenContract] = denom
+			return false
+		})
+
+		var locked sdk.Coins
+		lock := func(token *types.ERC20Token) {
+			if denom, ok := cosmosOriginated[token.Contract]; ok {
+				locked = locked.Add(sdk.NewCoin(denom, token.Amount))
+			}
+		}
+		for contract := range cosmosOriginated {
+			k.IterateOutgoingPoolByFee(ctx, contract, func(_ uint64, tx *types.OutgoingTx) bool {
+				lock(types.NewSDKIntERC20Token(tx.Amount.Amount, contract))
+				lock(types.NewSDKIntERC20Token(tx.BridgeFee.Amount, contract))
+				return false
+			})
+		}
+		k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+			for _, tx := range batch.Transactions {
+				lock(tx.Erc20Token)
+				lock(tx.Erc20Fee)
+			}
+			return false
+		})
+		k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+			for _, tokens := range [][]*types.ERC20Token{call.Transfers, call.Fees} {
+				for _, token := range tokens {
+					lock(token)
+				}
+			}
+			return false
+		})
+
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		var msg string
+		for _, coin := range locked {
+			if balance.AmountOf(coin.Denom).LT(coin.Amount) {
+				msg += fmt.Sprintf("\tmodule holds %s%s, %s locked\n", balance.AmountOf(coin.Denom), coin.Denom, coin)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "module balance",
+			fmt.Sprintf("module balance does not cover locked Cosmos originated coins:\n%s", msg)), msg != ""
+	}
+}
+
+// OutgoingPoolInvariant checks that every transaction in the outgoing pool is either in the fee index
+// and available for batching or in exactly one batch
+func OutgoingPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		seen := make(map[uint64]int)
+		k.IterateOutgoingPoolFeeIndex(ctx, func(ids []uint64) bool {
+			for _, id := range ids {
+				seen[id]++
+			}
+			return false
+		})
+		k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+			for _, tx := range batch.Transactions {
+				seen[tx.Id]++
+			}
+			return false
+		})
+
+		var msg string
+		k.IterateOutgoingPool(ctx, func(id uint64, _ *types.OutgoingTx) bool {
+			if seen[id] != 1 {
+				msg += fmt.Sprintf("\ttx %d is indexed %d times\n", id, seen[id])
+			}
+			delete(seen, id)
+			return false
+		})
+		for id := range seen {
+			msg += fmt.Sprintf("\ttx %d is indexed but not in the pool\n", id)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "outgoing pool",
+			fmt.Sprintf("outgoing pool and its indexes are inconsistent:\n%s", msg)), msg != ""
+	}
+}
+
+// ObservedNonceInvariant checks that the last observed event nonce is not below the nonce of any
+// observed attestation
+func ObservedNonceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastObserved := k.GetLastObservedEventNonce(ctx)
+		var msg string
+		k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+			if !att.Observed {
+				return false
+			}
+			claim, err := k.UnpackAttestationClaim(&att)
+			if err != nil {
+				msg += fmt.Sprintf("\tattestation claim can not be unpacked: %s\n", err)
+				return false
+			}
+			if claim.GetEventNonce() > lastObserved {
+				msg += fmt.Sprintf("\tobserved attestation nonce %d is above the last observed nonce %d\n", claim.GetEventNonce(), lastObserved)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "observed nonce",
+			fmt.Sprintf("last observed event nonce is behind observed attestations:\n%s", msg)), msg != ""
+	}
+}
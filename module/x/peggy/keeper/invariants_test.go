@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariants(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		mySender, _       = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver        = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		stakeContractAddr = "0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"
+		logicContractAddr = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", stakeContractAddr)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	// lock stake in the pool, a batch and a logic call
+	for i := int64(1); i <= 4; i++ {
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("stake", i))
+		require.NoError(t, err)
+	}
+	_, err := k.BuildOutgoingTXBatch(ctx, stakeContractAddr, 2)
+	require.NoError(t, err)
+	_, err = k.CreateLogicCall(ctx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), nil, logicContractAddr, nil, 500)
+	require.NoError(t, err)
+
+	k.setLastObservedEventNonce(ctx, 2)
+	k.SetAttestation(ctx, 2, []byte("claim"), &types.Attestation{Observed: true, Claim: mustPackClaim(t, &types.MsgWithdrawClaim{EventNonce: 2})})
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	// the batched tx is also available in the pool
+	batch := k.GetOutgoingTXBatch(ctx, stakeContractAddr, 1)
+	require.NotNil(t, batch)
+	k.appendToUnbatchedTXIndex(ctx, stakeContractAddr, sdk.NewInt64Coin("stake", 4), batch.Transactions[0].Id)
+	_, broken = OutgoingPoolInvariant(k)(ctx)
+	assert.True(t, broken)
+	require.NoError(t, k.removeFromUnbatchedTXIndex(ctx, stakeContractAddr, sdk.NewInt64Coin("stake", 4), batch.Transactions[0].Id))
+
+	// locked stake left the module
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	assert.True(t, broken)
+
+	// an observed attestation is ahead of the last observed nonce
+	k.SetAttestation(ctx, 3, []byte("claim"), &types.Attestation{Observed: true, Claim: mustPackClaim(t, &types.MsgWithdrawClaim{EventNonce: 3})})
+	_, broken = ObservedNonceInvariant(k)(ctx)
+	assert.True(t, broken)
+}
+
+func mustPackClaim(t *testing.T, claim proto.Message) *codectypes.Any {
+	any, err := codectypes.NewAnyWithValue(claim)
+	require.NoError(t, err)
+	return any
+}
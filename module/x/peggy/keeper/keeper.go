@@ -21,22 +21,28 @@ type Keeper struct {
 	storeKey   sdk.StoreKey // Unexposed key to access store from sdk.Context
 	paramSpace paramtypes.Subspace
 
-	cdc        codec.BinaryMarshaler // The wire codec for binary encoding/decoding.
-	bankKeeper types.BankKeeper
+	cdc            codec.BinaryMarshaler // The wire codec for binary encoding/decoding.
+	bankKeeper     types.BankKeeper
+	transferKeeper types.IBCTransferKeeper
 
 	AttestationHandler interface {
 		Handle(sdk.Context, types.Attestation, types.EthereumClaim) error
 	}
+
+	logicCallHooks map[string]types.LogicCallHooks
 }
 
 // NewKeeper returns a new instance of the peggy keeper
-func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper, transferKeeper types.IBCTransferKeeper) Keeper {
 	k := Keeper{
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		storeKey:      storeKey,
-		StakingKeeper: stakingKeeper,
-		bankKeeper:    bankKeeper,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		storeKey:       storeKey,
+		StakingKeeper:  stakingKeeper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+
+		logicCallHooks: make(map[string]types.LogicCallHooks),
 	}
 	k.AttestationHandler = AttestationHandler{
 		keeper:     k,
@@ -160,6 +166,7 @@ func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConf
 		panic(err)
 	}
 	key := types.GetValsetConfirmKey(valsetConf.Nonce, addr)
+	valsetConf.EthAddress = types.NormalizeEthAddress(valsetConf.EthAddress)
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&valsetConf))
 	return key
 }
@@ -223,7 +230,10 @@ func (k Keeper) SetBatchConfirm(ctx sdk.Context, batch *types.MsgConfirmBatch) [
 		panic(err)
 	}
 	key := types.GetBatchConfirmKey(batch.TokenContract, batch.Nonce, acc)
-	store.Set(key, k.cdc.MustMarshalBinaryBare(batch))
+	confirm := *batch
+	confirm.TokenContract = types.NormalizeEthAddress(confirm.TokenContract)
+	confirm.EthSigner = types.NormalizeEthAddress(confirm.EthSigner)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&confirm))
 	return key
 }
 
@@ -232,7 +242,7 @@ func (k Keeper) SetBatchConfirm(ctx sdk.Context, batch *types.MsgConfirmBatch) [
 // TODO: specify which nonce this is
 func (k Keeper) IterateBatchConfirmByNonceAndTokenContract(ctx sdk.Context, nonce uint64, tokenContract string, cb func([]byte, types.MsgConfirmBatch) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchConfirmKey)
-	prefix := append([]byte(tokenContract), types.UInt64Bytes(nonce)...)
+	prefix := append(types.EthAddressBytes(tokenContract), types.UInt64Bytes(nonce)...)
 	iter := prefixStore.Iterator(prefixRange(prefix))
 	defer iter.Close()
 
@@ -259,10 +269,15 @@ func (k Keeper) GetBatchConfirmByNonceAndTokenContract(ctx sdk.Context, nonce ui
 //    ADDRESS DELEGATION   //
 /////////////////////////////
 
-// SetOrchestratorValidator sets the Orchestrator key for a given validator
+// SetOrchestratorValidator sets the Orchestrator key for a given validator, any
+// orchestrator key previously set for the validator is released
 func (k Keeper) SetOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
+	if prev := k.GetValidatorOrchestrator(ctx, val); prev != nil && !prev.Equals(orch) {
+		store.Delete(types.GetOrchestratorAddressKey(prev))
+	}
 	store.Set(types.GetOrchestratorAddressKey(orch), val.Bytes())
+	store.Set(types.GetValidatorOrchestratorKey(val), orch.Bytes())
 }
 
 // GetOrchestratorValidator returns the validator key associated with an orchestrator key
@@ -271,14 +286,62 @@ func (k Keeper) GetOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) s
 	return sdk.ValAddress(store.Get(types.GetOrchestratorAddressKey(orch)))
 }
 
+// GetValidatorOrchestrator returns the orchestrator key associated with a validator
+func (k Keeper) GetValidatorOrchestrator(ctx sdk.Context, val sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.AccAddress(store.Get(types.GetValidatorOrchestratorKey(val)))
+}
+
+// GetDelegateKeyNonce returns the nonce a validator's Ethereum key has to sign
+// over the next time the validator sets its delegate keys
+func (k Keeper) GetDelegateKeyNonce(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetDelegateKeyNonceKey(val))
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bytes)
+}
+
+// incrementDelegateKeyNonce invalidates all outstanding delegate key signatures
+// of a validator by moving its delegate key nonce forward
+func (k Keeper) incrementDelegateKeyNonce(ctx sdk.Context, val sdk.ValAddress) {
+	k.setDelegateKeyNonce(ctx, val, k.GetDelegateKeyNonce(ctx, val)+1)
+}
+
+func (k Keeper) setDelegateKeyNonce(ctx sdk.Context, val sdk.ValAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegateKeyNonceKey(val), types.UInt64Bytes(nonce))
+}
+
+// IterateDelegateKeyNonces iterates through the delegate key nonces of all validators
+func (k Keeper) IterateDelegateKeyNonces(ctx sdk.Context, cb func(sdk.ValAddress, uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyDelegateKeyNonce)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
 /////////////////////////////
 //       ETH ADDRESS       //
 /////////////////////////////
 
-// SetEthAddress sets the ethereum address for a given validator
+// SetEthAddress sets the ethereum address for a given validator, any ethereum
+// address previously set for the validator is released
 func (k Keeper) SetEthAddress(ctx sdk.Context, validator sdk.ValAddress, ethAddr string) {
 	store := ctx.KVStore(k.storeKey)
+	ethAddr = types.NormalizeEthAddress(ethAddr)
+	if prev := k.GetEthAddress(ctx, validator); prev != "" && prev != ethAddr {
+		store.Delete(types.GetEthAddressValidatorKey(prev))
+	}
 	store.Set(types.GetEthAddressKey(validator), []byte(ethAddr))
+	store.Set(types.GetEthAddressValidatorKey(ethAddr), validator.Bytes())
 }
 
 // GetEthAddress returns the eth address for a given peggy validator
@@ -287,6 +350,12 @@ func (k Keeper) GetEthAddress(ctx sdk.Context, validator sdk.ValAddress) string
 	return string(store.Get(types.GetEthAddressKey(validator)))
 }
 
+// GetEthAddressValidator returns the validator that registered a given eth address
+func (k Keeper) GetEthAddressValidator(ctx sdk.Context, ethAddr string) sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.ValAddress(store.Get(types.GetEthAddressValidatorKey(ethAddr)))
+}
+
 // GetCurrentValset gets powers from the store and normalizes them
 // into an integer percentage with a resolution of uint32 Max meaning
 // a given validators 'Peggy power' is computed as
@@ -331,8 +400,12 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) *types.Valset {
 // GetOutgoingLogicCall gets an outgoing logic call
 func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutgoingLogicCallKey(invalidationId, invalidationNonce))
+	if bz == nil {
+		return nil
+	}
 	call := types.OutgoingLogicCall{}
-	k.cdc.MustUnmarshalBinaryBare(store.Get(types.GetOutgoingLogicCallKey(invalidationId, invalidationNonce)), &call)
+	k.cdc.MustUnmarshalBinaryBare(bz, &call)
 	return &call
 }
 
@@ -372,14 +445,18 @@ func (k Keeper) GetOutgoingLogicCalls(ctx sdk.Context) (out []*types.OutgoingLog
 	return
 }
 
-// CancelOutgoingLogicCalls releases all TX in the batch and deletes the batch
+// CancelOutgoingLogicCall refunds the tokens of the call, deletes it and notifies the module that created it
 func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) error {
 	call := k.GetOutgoingLogicCall(ctx, invalidationId, invalidationNonce)
 	if call == nil {
 		return types.ErrUnknown
 	}
+	if err := k.refundLogicCall(ctx, call); err != nil {
+		return err
+	}
 	// Delete batch since it is finished
 	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+	k.deleteLogicCallConfirms(ctx, call.InvalidationId, call.InvalidationNonce)
 
 	// a consuming application will have to watch for this event and act on it
 	batchEvent := sdk.NewEvent(
@@ -389,6 +466,10 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
 	)
 	ctx.EventManager().EmitEvent(batchEvent)
+
+	if hooks, ok := k.logicCallHooks[call.SourceModule]; ok {
+		hooks.AfterLogicCallCanceled(ctx, *call)
+	}
 	return nil
 }
 
@@ -398,7 +479,9 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 
 // SetLogicCallConfirm sets a logic confirm in the store
 func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, val sdk.AccAddress, msg *types.MsgConfirmLogicCall) {
-	ctx.KVStore(k.storeKey).Set(types.GetLogicConfirmKey(msg.InvalidationId, msg.InvalidationNonce, val), k.cdc.MustMarshalBinaryBare(msg))
+	confirm := *msg
+	confirm.EthSigner = types.NormalizeEthAddress(confirm.EthSigner)
+	ctx.KVStore(k.storeKey).Set(types.GetLogicConfirmKey(msg.InvalidationId, msg.InvalidationNonce, val), k.cdc.MustMarshalBinaryBare(&confirm))
 }
 
 // GetLogicCallConfirm gets a logic confirm from the store
@@ -429,6 +512,19 @@ func (k Keeper) IterateLogicConfirmByInvalidationIdAndNonce(ctx sdk.Context, inv
 	}
 }
 
+// deleteLogicCallConfirms deletes all confirms of a logic call
+func (k Keeper) deleteLogicCallConfirms(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) {
+	var keys [][]byte
+	k.IterateLogicConfirmByInvalidationIdAndNonce(ctx, invalidationId, invalidationNonce, func(key []byte, _ *types.MsgConfirmLogicCall) bool {
+		keys = append(keys, append(types.KeyOutgoingLogicConfirm, key...))
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetLogicConfirmsByInvalidationIdAndNonce returns the logic call confirms
 func (k Keeper) GetLogicConfirmByInvalidationIdAndNonce(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) (out []types.MsgConfirmLogicCall) {
 	k.IterateLogicConfirmByInvalidationIdAndNonce(ctx, invalidationId, invalidationNonce, func(_ []byte, msg *types.MsgConfirmLogicCall) bool {
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateLogicCall creates an outgoing logic call on behalf of another module
+// - converts the transfers and fees to their ERC20 counterparts
+// - locks Cosmos originated coins and burns Ethereum originated vouchers taken from the module account
+// - assigns the invalidation id of the module and its next invalidation nonce
+// - persists an OutgoingLogicCall for the validators to sign
+// The timeout is an Ethereum block height after which the call can no longer be executed.
+func (k Keeper) CreateLogicCall(
+	ctx sdk.Context,
+	sourceModule string,
+	transfers sdk.Coins,
+	fees sdk.Coins,
+	logicContract string,
+	payload []byte,
+	timeout uint64,
+) (*types.OutgoingLogicCall, error) {
+	if sourceModule == "" {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "source module")
+	}
+	if !transfers.IsValid() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "transfers")
+	}
+	if !fees.IsValid() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fees")
+	}
+	if err := types.ValidateEthAddress(logicContract); err != nil {
+		return nil, sdkerrors.Wrap(err, "logic contract address")
+	}
+	if timeout <= k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+		return nil, sdkerrors.Wrap(types.ErrTimeout, "timeout already passed on Ethereum")
+	}
+
+	erc20Transfers, burn, err := k.coinsToERC20Tokens(ctx, transfers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "transfers")
+	}
+	erc20Fees, burnFees, err := k.coinsToERC20Tokens(ctx, fees)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "fees")
+	}
+	burn = burn.Add(burnFees...)
+
+	// take the coins from the module, Cosmos originated coins stay locked in the peggy module
+	if total := transfers.Add(fees...); !total.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, sourceModule, types.ModuleName, total); err != nil {
+			return nil, err
+		}
+	}
+	// burn vouchers to send them back to ETH
+	if !burn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+			panic(err)
+		}
+	}
+
+	call := &types.OutgoingLogicCall{
+		Transfers:            erc20Transfers,
+		Fees:                 erc20Fees,
+		LogicContractAddress: types.NormalizeEthAddress(logicContract),
+		Payload:              payload,
+		Timeout:              timeout,
+		InvalidationId:       types.GetLogicCallInvalidationID(sourceModule),
+		InvalidationNonce:    k.incrementLastLogicCallNonce(ctx, sourceModule),
+		Block:                uint64(ctx.BlockHeight()),
+		SourceModule:         sourceModule,
+	}
+	k.SetOutogingLogicCall(ctx, call)
+
+	logicCallEvent := sdk.NewEvent(
+		types.EventTypeOutgoingLogicCall,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, fmt.Sprint(k.GetBridgeChainID(ctx))),
+		sdk.NewAttribute(types.AttributeKeySourceModule, sourceModule),
+		sdk.NewAttribute(types.AttributeKeyLogicContract, call.LogicContractAddress),
+		sdk.NewAttribute(types.AttributeKeyInvalidationID, hex.EncodeToString(call.InvalidationId)),
+		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
+	)
+	ctx.EventManager().EmitEvent(logicCallEvent)
+
+	return call, nil
+}
+
+// OutgoingLogicCallExecuted is run when the Cosmos chain detects that a logic call has been executed on Ethereum.
+// It deletes the call along with its confirms, cancels the calls it invalidated and calls back the module
+// that created it.
+func (k Keeper) OutgoingLogicCallExecuted(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) error {
+	call := k.GetOutgoingLogicCall(ctx, invalidationId, invalidationNonce)
+	if call == nil {
+		return sdkerrors.Wrap(types.ErrUnknown, "logic call")
+	}
+
+	// Ethereum rejects calls with the same invalidation id and a lower nonce from now on
+	var invalidated []*types.OutgoingLogicCall
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, other *types.OutgoingLogicCall) bool {
+		if bytes.Equal(other.InvalidationId, call.InvalidationId) && other.InvalidationNonce < call.InvalidationNonce {
+			invalidated = append(invalidated, other)
+		}
+		return false
+	})
+	for _, other := range invalidated {
+		if err := k.CancelOutgoingLogicCall(ctx, other.InvalidationId, other.InvalidationNonce); err != nil {
+			return err
+		}
+	}
+
+	// Delete call since it is finished
+	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+	k.deleteLogicCallConfirms(ctx, call.InvalidationId, call.InvalidationNonce)
+
+	executedEvent := sdk.NewEvent(
+		types.EventTypeOutgoingLogicCallExecuted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeySourceModule, call.SourceModule),
+		sdk.NewAttribute(types.AttributeKeyLogicContract, call.LogicContractAddress),
+		sdk.NewAttribute(types.AttributeKeyInvalidationID, hex.EncodeToString(call.InvalidationId)),
+		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
+	)
+	ctx.EventManager().EmitEvent(executedEvent)
+
+	if hooks, ok := k.logicCallHooks[call.SourceModule]; ok {
+		hooks.AfterLogicCallExecuted(ctx, *call)
+	}
+	return nil
+}
+
+// refundLogicCall returns the transfers and fees of a call that will not be executed to the module
+// that created it, Ethereum originated vouchers are minted again and Cosmos originated coins unlocked
+func (k Keeper) refundLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) error {
+	// calls imported from genesis were not created through CreateLogicCall and escrow nothing
+	if call.SourceModule == "" {
+		return nil
+	}
+
+	var refund, mint sdk.Coins
+	for _, tokens := range [][]*types.ERC20Token{call.Transfers, call.Fees} {
+		for _, token := range tokens {
+			isCosmosOriginated, denom := k.ERC20ToDenom(ctx, token.Contract)
+			coin := sdk.NewCoin(denom, token.Amount)
+			if !isCosmosOriginated {
+				mint = mint.Add(coin)
+			}
+			refund = refund.Add(coin)
+		}
+	}
+
+	if !mint.IsZero() {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mint); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", mint)
+		}
+	}
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, call.SourceModule, refund); err != nil {
+			return sdkerrors.Wrap(err, "refund logic call")
+		}
+	}
+	return nil
+}
+
+// SetLogicCallHooks registers the hooks peggy calls back for the logic calls created by a module
+func (k Keeper) SetLogicCallHooks(module string, hooks types.LogicCallHooks) Keeper {
+	if _, ok := k.logicCallHooks[module]; ok {
+		panic(fmt.Sprintf("cannot set logic call hooks of module %s twice", module))
+	}
+	k.logicCallHooks[module] = hooks
+	return k
+}
+
+// coinsToERC20Tokens converts coins to their ERC20 counterparts and returns the Ethereum
+// originated part that has to be burned
+func (k Keeper) coinsToERC20Tokens(ctx sdk.Context, coins sdk.Coins) (tokens []*types.ERC20Token, burn sdk.Coins, err error) {
+	for _, coin := range coins {
+		isCosmosOriginated, tokenContract, err := k.DenomToERC20(ctx, coin.Denom)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isCosmosOriginated {
+			burn = burn.Add(coin)
+		}
+		tokens = append(tokens, &types.ERC20Token{Contract: tokenContract, Amount: coin.Amount})
+	}
+	return tokens, burn, nil
+}
+
+// GetLastLogicCallNonce returns the last invalidation nonce assigned to a logic call of the module
+func (k Keeper) GetLastLogicCallNonce(ctx sdk.Context, module string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetLastLogicCallNonceKey(module))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// SetLastLogicCallNonce sets the last invalidation nonce assigned to a logic call of the module
+func (k Keeper) SetLastLogicCallNonce(ctx sdk.Context, module string, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetLastLogicCallNonceKey(module), types.UInt64Bytes(nonce))
+}
+
+// IterateLastLogicCallNonces iterates through the last invalidation nonces of all modules
+func (k Keeper) IterateLastLogicCallNonces(ctx sdk.Context, cb func(module string, nonce uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyLastLogicCallNonce)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(string(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) incrementLastLogicCallNonce(ctx sdk.Context, module string) uint64 {
+	nonce := k.GetLastLogicCallNonce(ctx, module) + 1
+	k.SetLastLogicCallNonce(ctx, module, nonce)
+	return nonce
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLogicCall(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		stakeContractAddr   = "0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"
+		logicContractAddr   = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		distrAddr           = authtypes.NewModuleAddress(distrtypes.ModuleName)
+		peggyAddr           = authtypes.NewModuleAddress(types.ModuleName)
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", stakeContractAddr)
+
+	// fund the distribution module with some vouchers
+	vouchers := sdk.Coins{types.NewERC20Token(1000, myTokenContractAddr).PeggyCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, vouchers))
+
+	transfers := sdk.NewCoins(types.NewERC20Token(100, myTokenContractAddr).PeggyCoin(), sdk.NewInt64Coin("stake", 50))
+	fees := sdk.NewCoins(types.NewERC20Token(10, myTokenContractAddr).PeggyCoin())
+
+	// when
+	ctx = ctx.WithBlockHeight(100)
+	call, err := k.CreateLogicCall(ctx, distrtypes.ModuleName, transfers, fees, logicContractAddr, []byte("payload"), 500)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, types.GetLogicCallInvalidationID(distrtypes.ModuleName), call.InvalidationId)
+	assert.Equal(t, uint64(1), call.InvalidationNonce)
+	assert.Equal(t, uint64(100), call.Block)
+	assert.Equal(t, distrtypes.ModuleName, call.SourceModule)
+	assert.Equal(t, []*types.ERC20Token{
+		types.NewERC20Token(100, myTokenContractAddr),
+		types.NewERC20Token(50, stakeContractAddr),
+	}, call.Transfers)
+	assert.Equal(t, []*types.ERC20Token{types.NewERC20Token(10, myTokenContractAddr)}, call.Fees)
+	assert.Equal(t, call, k.GetOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce))
+
+	// vouchers are burned and stake is locked
+	assert.Equal(t, sdk.NewCoins(types.NewERC20Token(890, myTokenContractAddr).PeggyCoin(), sdk.NewInt64Coin("stake", 499950)), input.BankKeeper.GetAllBalances(ctx, distrAddr))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), input.BankKeeper.GetAllBalances(ctx, peggyAddr))
+	assert.Equal(t, sdk.NewInt(890), input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(types.NewERC20Token(0, myTokenContractAddr).PeggyCoin().Denom))
+
+	// nonces are assigned per module
+	next, err := k.CreateLogicCall(ctx, distrtypes.ModuleName, nil, nil, logicContractAddr, nil, 500)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), next.InvalidationNonce)
+	other, err := k.CreateLogicCall(ctx, authtypes.FeeCollectorName, nil, nil, logicContractAddr, nil, 500)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), other.InvalidationNonce)
+	assert.NotEqual(t, call.InvalidationId, other.InvalidationId)
+
+	// insufficient funds
+	_, err = k.CreateLogicCall(ctx, distrtypes.ModuleName, sdk.NewCoins(types.NewERC20Token(10000, myTokenContractAddr).PeggyCoin()), nil, logicContractAddr, nil, 500)
+	assert.Error(t, err)
+	// unknown denom
+	_, err = k.CreateLogicCall(ctx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("unknown", 1)), nil, logicContractAddr, nil, 500)
+	assert.Error(t, err)
+
+	// timeout already passed on Ethereum
+	k.SetLastObservedEthereumBlockHeight(ctx, 500)
+	_, err = k.CreateLogicCall(ctx, distrtypes.ModuleName, nil, nil, logicContractAddr, nil, 500)
+	assert.Error(t, err)
+}
+
+type mockLogicCallHooks struct {
+	executed []types.OutgoingLogicCall
+	canceled []types.OutgoingLogicCall
+}
+
+func (h *mockLogicCallHooks) AfterLogicCallExecuted(_ sdk.Context, call types.OutgoingLogicCall) {
+	h.executed = append(h.executed, call)
+}
+
+func (h *mockLogicCallHooks) AfterLogicCallCanceled(_ sdk.Context, call types.OutgoingLogicCall) {
+	h.canceled = append(h.canceled, call)
+}
+
+func TestLogicCallExecuted(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		logicContractAddr = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		orchAddr, _       = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	)
+	hooks := &mockLogicCallHooks{}
+	k.SetLogicCallHooks(distrtypes.ModuleName, hooks)
+
+	var calls []*types.OutgoingLogicCall
+	for i := 0; i < 3; i++ {
+		call, err := k.CreateLogicCall(ctx, distrtypes.ModuleName, nil, nil, logicContractAddr, nil, 500)
+		require.NoError(t, err)
+		calls = append(calls, call)
+	}
+	other, err := k.CreateLogicCall(ctx, authtypes.FeeCollectorName, nil, nil, logicContractAddr, nil, 500)
+	require.NoError(t, err)
+	for _, call := range calls[:2] {
+		k.SetLogicCallConfirm(ctx, orchAddr, &types.MsgConfirmLogicCall{
+			InvalidationId:    call.InvalidationId,
+			InvalidationNonce: call.InvalidationNonce,
+			Orchestrator:      orchAddr.String(),
+		})
+	}
+
+	// when
+	claim := &types.MsgLogicCallExecutedClaim{InvalidationId: calls[1].InvalidationId, InvalidationNonce: calls[1].InvalidationNonce}
+	any, err := codectypes.NewAnyWithValue(claim)
+	require.NoError(t, err)
+	// drop the cached claim as if the attestation was read from the store
+	att := types.Attestation{Claim: &codectypes.Any{TypeUrl: any.TypeUrl, Value: any.Value}}
+	unpacked, err := k.UnpackAttestationClaim(&att)
+	require.NoError(t, err)
+	require.NoError(t, k.AttestationHandler.Handle(ctx, att, unpacked))
+
+	// then the executed call and the one it invalidated are gone along with their confirms
+	for _, call := range calls[:2] {
+		assert.Nil(t, k.GetOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce))
+		assert.Empty(t, k.GetLogicConfirmByInvalidationIdAndNonce(ctx, call.InvalidationId, call.InvalidationNonce))
+	}
+	assert.NotNil(t, k.GetOutgoingLogicCall(ctx, calls[2].InvalidationId, calls[2].InvalidationNonce))
+	assert.NotNil(t, k.GetOutgoingLogicCall(ctx, other.InvalidationId, other.InvalidationNonce))
+	assert.Equal(t, []types.OutgoingLogicCall{*calls[1]}, hooks.executed)
+	assert.Equal(t, []types.OutgoingLogicCall{*calls[0]}, hooks.canceled)
+
+	// and the same claim can not be applied twice
+	assert.Error(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, claim))
+}
+
+func TestLogicCallRefund(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		stakeContractAddr   = "0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"
+		logicContractAddr   = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		distrAddr           = authtypes.NewModuleAddress(distrtypes.ModuleName)
+		peggyAddr           = authtypes.NewModuleAddress(types.ModuleName)
+		voucherDenom        = types.NewERC20Token(0, myTokenContractAddr).PeggyCoin().Denom
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, "stake", stakeContractAddr)
+	hooks := &mockLogicCallHooks{}
+	k.SetLogicCallHooks(distrtypes.ModuleName, hooks)
+
+	vouchers := sdk.Coins{types.NewERC20Token(1000, myTokenContractAddr).PeggyCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, vouchers))
+	balance := input.BankKeeper.GetAllBalances(ctx, distrAddr)
+
+	transfers := sdk.NewCoins(types.NewERC20Token(100, myTokenContractAddr).PeggyCoin(), sdk.NewInt64Coin("stake", 50))
+	fees := sdk.NewCoins(types.NewERC20Token(10, myTokenContractAddr).PeggyCoin(), sdk.NewInt64Coin("stake", 5))
+	call, err := k.CreateLogicCall(ctx, distrtypes.ModuleName, transfers, fees, logicContractAddr, nil, 500)
+	require.NoError(t, err)
+	require.NotEqual(t, balance, input.BankKeeper.GetAllBalances(ctx, distrAddr))
+
+	// when
+	require.NoError(t, k.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce))
+
+	// then vouchers are minted again and stake is unlocked
+	assert.Nil(t, k.GetOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce))
+	assert.Equal(t, balance, input.BankKeeper.GetAllBalances(ctx, distrAddr))
+	assert.True(t, input.BankKeeper.GetAllBalances(ctx, peggyAddr).IsZero())
+	assert.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(voucherDenom))
+	assert.Equal(t, []types.OutgoingLogicCall{*call}, hooks.canceled)
+	assert.Empty(t, hooks.executed)
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/migrations"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator migrates the peggy store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate runs the store migrations up to the current consensus version
+func (m Migrator) Migrate(ctx sdk.Context) error {
+	return migrations.Migrate(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace, m.keeper.bankKeeper)
+}
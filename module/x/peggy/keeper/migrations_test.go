@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/migrations"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	k.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
+	k.SetEthAddress(ctx, ValAddrs[0], EthAddrs[0].String())
+
+	// roll the store back to version 1
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyStoreVersion)
+	store.Delete(types.GetValidatorOrchestratorKey(ValAddrs[0]))
+	store.Delete(types.GetEthAddressValidatorKey(EthAddrs[0].String()))
+	require.Nil(t, k.GetValidatorOrchestrator(ctx, ValAddrs[0]))
+	assert.Equal(t, uint64(1), migrations.GetStoreVersion(ctx, k.storeKey))
+
+	// when
+	require.NoError(t, NewMigrator(k).Migrate(ctx))
+
+	// then
+	assert.Equal(t, uint64(types.ConsensusVersion), migrations.GetStoreVersion(ctx, k.storeKey))
+	assert.Equal(t, AccAddrs[0], k.GetValidatorOrchestrator(ctx, ValAddrs[0]))
+	assert.Equal(t, ValAddrs[0], k.GetEthAddressValidator(ctx, EthAddrs[0].String()))
+
+	// migrating again is a no-op, a store ahead of the binary is rejected
+	require.NoError(t, NewMigrator(k).Migrate(ctx))
+	migrations.SetStoreVersion(ctx, k.storeKey, types.ConsensusVersion+1)
+	assert.Error(t, NewMigrator(k).Migrate(ctx))
+}
+
+func TestMigratorMergesVouchers(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		denom         = types.PeggyDenom(tokenContract)
+		lowerDenom    = "peggy" + strings.ToLower(tokenContract)
+	)
+	// vouchers of version 3 were minted in the casing of the claim
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin(lowerDenom, 5))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], vouchers))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(lowerDenom, 3))))
+	migrations.SetStoreVersion(ctx, k.storeKey, 3)
+
+	// when
+	require.NoError(t, NewMigrator(k).Migrate(ctx))
+
+	// then
+	assert.Equal(t, sdk.NewInt64Coin(denom, 15), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom))
+	assert.True(t, input.BankKeeper.GetBalance(ctx, AccAddrs[0], lowerDenom).IsZero())
+	moduleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	assert.Equal(t, sdk.NewInt64Coin(denom, 3), input.BankKeeper.GetBalance(ctx, moduleAddr, denom))
+	supply := input.BankKeeper.GetSupply(ctx).GetTotal()
+	assert.Equal(t, sdk.NewInt(18), supply.AmountOf(denom))
+	assert.True(t, supply.AmountOf(lowerDenom).IsZero())
+}
@@ -40,14 +40,26 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
 	}
 
-	// TODO consider impact of maliciously setting duplicate delegate
-	// addresses since no signatures from the private keys of these addresses
-	// are required for this message it could be sent in a hostile way.
+	// once set delegate keys can only be changed by scheduling a rotation, switching
+	// them at once would invalidate signatures over objects already in flight
+	if k.GetEthAddress(ctx, val) != "" || k.GetValidatorOrchestrator(ctx, val) != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "delegate keys already set, use MsgRotateDelegateKeys")
+	}
+
+	// the orchestrator co-signs the transaction, the ethereum key has to prove
+	// that it consents to being used by this validator
+	if err := k.verifyDelegateKeysSignature(ctx, val, msg.EthAddress, msg.EthSignature); err != nil {
+		return nil, err
+	}
+	if err := k.checkDelegateKeysAvailable(ctx, val, orch, msg.EthAddress); err != nil {
+		return nil, err
+	}
 
 	// set the orchestrator address
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the ethereum address
 	k.SetEthAddress(ctx, val, msg.EthAddress)
+	k.incrementDelegateKeyNonce(ctx, val)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -61,6 +73,86 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 
 }
 
+// RotateDelegateKeys handles MsgRotateDelegateKeys
+func (k msgServer) RotateDelegateKeys(c context.Context, msg *types.MsgRotateDelegateKeys) (*types.MsgRotateDelegateKeysResponse, error) {
+	// ensure that this passes validation
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	val, _ := sdk.ValAddressFromBech32(msg.Validator)
+	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+
+	// ensure that the validator exists
+	if k.Keeper.StakingKeeper.Validator(ctx, val) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
+	}
+	if k.GetEthAddress(ctx, val) == "" && k.GetValidatorOrchestrator(ctx, val) == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "no delegate keys to rotate, use MsgSetOrchestratorAddress")
+	}
+	// only one set of previous keys is kept, they have to expire before rotating again
+	if k.GetPreviousDelegateKeys(ctx, val) != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "previous delegate keys still within their signing window")
+	}
+
+	if err := k.verifyDelegateKeysSignature(ctx, val, msg.EthAddress, msg.EthSignature); err != nil {
+		return nil, err
+	}
+	if err := k.checkDelegateKeysAvailable(ctx, val, orch, msg.EthAddress); err != nil {
+		return nil, err
+	}
+
+	// the new keys take effect after the grace period so that orchestrators have
+	// time to switch over, a rotation scheduled before replaces the previous one
+	rotation := &types.DelegateKeyRotation{
+		Validator:       msg.Validator,
+		Orchestrator:    msg.Orchestrator,
+		EthAddress:      msg.EthAddress,
+		EffectiveHeight: uint64(ctx.BlockHeight()) + k.GetParams(ctx).DelegateKeyRotationGracePeriod,
+	}
+	k.SetPendingDelegateKeyRotation(ctx, rotation)
+	k.incrementDelegateKeyNonce(ctx, val)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOrchestrator, msg.Orchestrator),
+			sdk.NewAttribute(types.AttributeKeyEthAddress, msg.EthAddress),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, fmt.Sprint(rotation.EffectiveHeight)),
+		),
+	)
+
+	return &types.MsgRotateDelegateKeysResponse{EffectiveHeight: rotation.EffectiveHeight}, nil
+}
+
+// SweepFallbackAccount handles MsgSweepFallbackAccount
+func (k msgServer) SweepFallbackAccount(c context.Context, msg *types.MsgSweepFallbackAccount) (*types.MsgSweepFallbackAccountResponse, error) {
+	// ensure that this passes validation
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	coins, err := k.Keeper.SweepFallbackAccount(ctx, msg.EthereumSender, receiver, msg.EthSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyEthereumSender, msg.EthereumSender),
+		),
+	)
+
+	return &types.MsgSweepFallbackAccountResponse{Amount: coins}, nil
+}
+
 // ValsetConfirm handles MsgValsetConfirm
 // TODO: check msgValsetConfirm to have an Orchestrator field instead of a Validator field
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
@@ -78,8 +170,9 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
 	}
 
+	// keys that were rotated away from remain valid for valsets created before the rotation
 	valaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	validator := k.GetOrchestratorValidator(ctx, valaddr)
+	validator := k.GetOrchestratorValidatorAt(ctx, valaddr, valset.Height)
 	if validator == nil {
 		sval := k.StakingKeeper.Validator(ctx, sdk.ValAddress(valaddr))
 		if sval == nil {
@@ -88,7 +181,7 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		validator = sval.GetOperator()
 	}
 
-	ethAddress := k.GetEthAddress(ctx, validator)
+	ethAddress := k.GetEthAddressAt(ctx, validator, valset.Height)
 	if ethAddress == "" {
 		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
@@ -134,7 +227,7 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 		),
 	)
 
-	return &types.MsgSendToEthResponse{}, nil
+	return &types.MsgSendToEthResponse{TxId: txID}, nil
 }
 
 // RequestBatch handles MsgRequestBatch
@@ -198,8 +291,9 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
 	}
 
+	// keys that were rotated away from remain valid for batches created before the rotation
 	valaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	validator := k.GetOrchestratorValidator(ctx, valaddr)
+	validator := k.GetOrchestratorValidatorAt(ctx, valaddr, batch.Block)
 	if validator == nil {
 		sval := k.StakingKeeper.Validator(ctx, sdk.ValAddress(valaddr))
 		if sval == nil {
@@ -208,7 +302,7 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		validator = sval.GetOperator()
 	}
 
-	ethAddress := k.GetEthAddress(ctx, validator)
+	ethAddress := k.GetEthAddressAt(ctx, validator, batch.Block)
 	if ethAddress == "" {
 		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
@@ -256,8 +350,9 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
 	}
 
+	// keys that were rotated away from remain valid for logic calls created before the rotation
 	valaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	validator := k.GetOrchestratorValidator(ctx, valaddr)
+	validator := k.GetOrchestratorValidatorAt(ctx, valaddr, logic.Block)
 	if validator == nil {
 		sval := k.StakingKeeper.Validator(ctx, sdk.ValAddress(valaddr))
 		if sval == nil {
@@ -266,7 +361,7 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 		validator = sval.GetOperator()
 	}
 
-	ethAddress := k.GetEthAddress(ctx, validator)
+	ethAddress := k.GetEthAddressAt(ctx, validator, logic.Block)
 	if ethAddress == "" {
 		return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
@@ -316,6 +411,7 @@ func (k msgServer) DepositClaim(c context.Context, msg *types.MsgDepositClaim) (
 		return nil, sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
 	}
 
+	types.NormalizeClaim(msg)
 	any, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		return nil, err
@@ -363,6 +459,7 @@ func (k msgServer) WithdrawClaim(c context.Context, msg *types.MsgWithdrawClaim)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in acitve set")
 	}
 
+	types.NormalizeClaim(msg)
 	any, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		return nil, err
@@ -407,6 +504,7 @@ func (k msgServer) ERC20DeployedClaim(c context.Context, msg *types.MsgERC20Depl
 		return nil, sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in acitve set")
 	}
 
+	types.NormalizeClaim(msg)
 	any, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		return nil, err
@@ -451,6 +549,7 @@ func (k msgServer) LogicCallExecutedClaim(c context.Context, msg *types.MsgLogic
 		return nil, sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in acitve set")
 	}
 
+	types.NormalizeClaim(msg)
 	any, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		return nil, err
@@ -474,3 +573,40 @@ func (k msgServer) LogicCallExecutedClaim(c context.Context, msg *types.MsgLogic
 
 	return &types.MsgLogicCallExecutedClaimResponse{}, nil
 }
+
+// ERC20MetadataClaim handles claims for the metadata of an Ethereum originated ERC20
+func (k msgServer) ERC20MetadataClaim(c context.Context, msg *types.MsgERC20MetadataClaim) (*types.MsgERC20MetadataClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orch, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	validator := k.GetOrchestratorValidator(ctx, orch)
+	if validator == nil {
+		sval := k.StakingKeeper.Validator(ctx, sdk.ValAddress(orch))
+		if sval == nil {
+			return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
+		}
+		validator = sval.GetOperator()
+	}
+
+	// return an error if the validator isn't in the active set
+	val := k.StakingKeeper.Validator(ctx, validator)
+	if val == nil || !val.IsBonded() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in acitve set")
+	}
+
+	msg.TokenContract = types.NormalizeEthAddress(msg.TokenContract)
+	if _, err := k.AttestERC20Metadata(ctx, validator, msg); err != nil {
+		return nil, sdkerrors.Wrap(err, "create attestation")
+	}
+
+	// Emit the handle message event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyAttestationID, string(types.GetERC20MetadataAttestationKey(msg.TokenContract, msg.ClaimHash()))),
+		),
+	)
+
+	return &types.MsgERC20MetadataClaimResponse{}, nil
+}
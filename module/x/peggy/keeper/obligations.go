@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+/////////////////////////////
+//  UNBONDING VALIDATORS   //
+/////////////////////////////
+
+// SetUnbondingValidator records a validator that left the bonded set
+func (k Keeper) SetUnbondingValidator(ctx sdk.Context, uv *types.UnbondingValidator) {
+	store := ctx.KVStore(k.storeKey)
+	val, err := sdk.ValAddressFromBech32(uv.Validator)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetUnbondingValidatorKey(val), k.cdc.MustMarshalBinaryBare(uv))
+}
+
+// GetUnbondingValidator returns the unbonding record of a validator, if any
+func (k Keeper) GetUnbondingValidator(ctx sdk.Context, val sdk.ValAddress) *types.UnbondingValidator {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnbondingValidatorKey(val))
+	if bz == nil {
+		return nil
+	}
+	var uv types.UnbondingValidator
+	k.cdc.MustUnmarshalBinaryBare(bz, &uv)
+	return &uv
+}
+
+// DeleteUnbondingValidator deletes the unbonding record of a validator
+func (k Keeper) DeleteUnbondingValidator(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetUnbondingValidatorKey(val))
+}
+
+// IterateUnbondingValidators iterates through all unbonding validator records
+func (k Keeper) IterateUnbondingValidators(ctx sdk.Context, cb func(*types.UnbondingValidator) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyUnbondingValidator)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var uv types.UnbondingValidator
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &uv)
+		// cb returns true to stop early
+		if cb(&uv) {
+			break
+		}
+	}
+}
+
+// PruneUnbondingValidators removes the records of validators whose signing windows for
+// everything created while they were bonded are over
+func (k Keeper) PruneUnbondingValidators(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	params := k.GetParams(ctx)
+	window := params.SignedValsetsWindow
+	if params.SignedBatchesWindow > window {
+		window = params.SignedBatchesWindow
+	}
+
+	var expired []sdk.ValAddress
+	k.IterateUnbondingValidators(ctx, func(uv *types.UnbondingValidator) bool {
+		if height > uv.UnbondingHeight+window {
+			val, _ := sdk.ValAddressFromBech32(uv.Validator)
+			expired = append(expired, val)
+		}
+		return false
+	})
+	for _, val := range expired {
+		k.DeleteUnbondingValidator(ctx, val)
+	}
+}
+
+// GetLastSlashedLogicCallBlock returns the creation height up to which logic calls were
+// checked for missing signatures
+func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastSlashedLogicCallBlock)
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// SetLastSlashedLogicCallBlock sets the creation height up to which logic calls were
+// checked for missing signatures
+func (k Keeper) SetLastSlashedLogicCallBlock(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastSlashedLogicCallBlock, types.UInt64Bytes(height))
+}
+
+/////////////////////////////
+//   SIGNING OBLIGATIONS   //
+/////////////////////////////
+
+// LiableValidator is a validator that has to sign an object, along with the power and
+// infraction height it is slashed with if it does not
+type LiableValidator struct {
+	Validator        stakingtypes.ValidatorI
+	Power            int64
+	InfractionHeight int64
+}
+
+// GetLiableValidators returns the validators that have to sign a valset, batch or logic
+// call created at the given height. These are the bonded validators and the validators
+// that began unbonding after the object was created. The latter are slashed with the
+// power they had while bonded and from the height the object was created at, so that
+// stake unbonded since then is slashed as well
+func (k Keeper) GetLiableValidators(ctx sdk.Context, height uint64) (out []LiableValidator) {
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if liable, ok := k.liability(ctx, val, height); ok {
+			out = append(out, liable)
+		}
+	}
+	k.IterateUnbondingValidators(ctx, func(uv *types.UnbondingValidator) bool {
+		valAddr, _ := sdk.ValAddressFromBech32(uv.Validator)
+		val := k.StakingKeeper.Validator(ctx, valAddr)
+		// bonded validators are already covered above
+		if val == nil || val.IsBonded() {
+			return false
+		}
+		if liable, ok := k.liability(ctx, val, height); ok {
+			out = append(out, liable)
+		}
+		return false
+	})
+	return out
+}
+
+// isLiable reports whether the validator has to sign an object created at the given height
+func (k Keeper) isLiable(ctx sdk.Context, val sdk.ValAddress, height uint64) bool {
+	sval := k.StakingKeeper.Validator(ctx, val)
+	if sval == nil {
+		return false
+	}
+	_, ok := k.liability(ctx, sval, height)
+	return ok
+}
+
+// liability returns the power and infraction height a validator is slashed with for not
+// signing an object created at the given height, ok is false if the validator is not liable.
+// Jailed validators that are still bonded are not liable, they begin unbonding at the end
+// of the block and are liable from then on for objects created before
+func (k Keeper) liability(ctx sdk.Context, val stakingtypes.ValidatorI, height uint64) (liable LiableValidator, ok bool) {
+	if val.IsBonded() {
+		if val.IsJailed() {
+			return liable, false
+		}
+		return LiableValidator{
+			Validator:        val,
+			Power:            val.GetConsensusPower(),
+			InfractionHeight: ctx.BlockHeight(),
+		}, true
+	}
+	// unbonded validators can not be slashed anymore
+	if !val.IsUnbonding() {
+		return liable, false
+	}
+	uv := k.GetUnbondingValidator(ctx, val.GetOperator())
+	if uv == nil || uv.UnbondingHeight <= height {
+		return liable, false
+	}
+	return LiableValidator{
+		Validator:        val,
+		Power:            uv.Power,
+		InfractionHeight: int64(height),
+	}, true
+}
+
+// HasSignedValset reports whether one of the confirms was made with the eth address
+// the validator had when the valset was created
+func (k Keeper) HasSignedValset(ctx sdk.Context, val sdk.ValAddress, valset *types.Valset, confirms []*types.MsgValsetConfirm) bool {
+	ethAddress := k.GetEthAddressAt(ctx, val, valset.Height)
+	for _, conf := range confirms {
+		if conf.EthAddress == ethAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSignedBatch reports whether one of the confirms was made by an orchestrator
+// acting for the validator when the batch was created
+func (k Keeper) HasSignedBatch(ctx sdk.Context, val sdk.ValAddress, batch *types.OutgoingTxBatch, confirms []types.MsgConfirmBatch) bool {
+	for _, conf := range confirms {
+		// TODO: double check this logic
+		confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
+		if k.GetOrchestratorValidatorAt(ctx, confVal, batch.Block).Equals(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSignedLogicCall reports whether one of the confirms was made by an orchestrator
+// acting for the validator when the logic call was created
+func (k Keeper) HasSignedLogicCall(ctx sdk.Context, val sdk.ValAddress, call *types.OutgoingLogicCall, confirms []types.MsgConfirmLogicCall) bool {
+	for _, conf := range confirms {
+		confVal, _ := sdk.AccAddressFromBech32(conf.Orchestrator)
+		if k.GetOrchestratorValidatorAt(ctx, confVal, call.Block).Equals(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetValidatorObligations returns the valsets, batches and logic calls the validator
+// is liable for and has not signed yet
+func (k Keeper) GetValidatorObligations(ctx sdk.Context, val sdk.ValAddress) (valsets []*types.Valset, batches []*types.OutgoingTxBatch, calls []*types.OutgoingLogicCall) {
+	for _, vs := range k.GetValsets(ctx) {
+		if k.isLiable(ctx, val, vs.Height) && !k.HasSignedValset(ctx, val, vs, k.GetValsetConfirms(ctx, vs.Nonce)) {
+			valsets = append(valsets, vs)
+		}
+	}
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		if k.isLiable(ctx, val, batch.Block) && !k.HasSignedBatch(ctx, val, batch, k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)) {
+			batches = append(batches, batch)
+		}
+	}
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		if k.isLiable(ctx, val, call.Block) && !k.HasSignedLogicCall(ctx, val, call, k.GetLogicConfirmByInvalidationIdAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)) {
+			calls = append(calls, call)
+		}
+	}
+	return valsets, batches, calls
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiableValidatorsJailed(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.PeggyKeeper
+	val := ValAddrs[0]
+	liable := func(height uint64) bool {
+		for _, v := range k.GetLiableValidators(ctx, height) {
+			if v.Validator.GetOperator().Equals(val) {
+				return true
+			}
+		}
+		return false
+	}
+
+	ctx = ctx.WithBlockHeight(20)
+	assert.True(t, k.isLiable(ctx, val, 10))
+	assert.True(t, liable(10))
+
+	// a jailed validator is still bonded until the staking EndBlocker runs
+	input.StakingKeeper.Jail(ctx, sdk.ConsAddress(ConsPubKeys[0].Address()))
+	assert.False(t, k.isLiable(ctx, val, 10))
+	assert.False(t, liable(10))
+
+	// once unbonding it is liable for the objects created before
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	ctx = ctx.WithBlockHeight(30)
+	assert.True(t, k.isLiable(ctx, val, 10))
+	assert.True(t, liable(10))
+	assert.False(t, k.isLiable(ctx, val, 25))
+	assert.False(t, liable(25))
+	assert.Len(t, k.GetLiableValidators(ctx, 25), 4)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// reverseStore iterates a store in descending key order, query.Paginate pages over it to
+// return the latest entries first
+type reverseStore struct {
+	sdk.KVStore
+}
+
+// Iterator iterates from start down to end, both inclusive, a nil start is the last key of the store
+func (s reverseStore) Iterator(start, end []byte) sdk.Iterator {
+	if start != nil {
+		// the smallest key after start, which makes start inclusive
+		start = append(append([]byte{}, start...), 0x00)
+	}
+	return s.KVStore.ReverseIterator(end, start)
+}
+
+// tokenContractStore iterates the first entry of every token contract in a store keyed by the
+// token contract bytes, query.Paginate pages over it to return one entry per token contract
+type tokenContractStore struct {
+	sdk.KVStore
+}
+
+// Iterator iterates the first entry of every token contract from start to end
+func (s tokenContractStore) Iterator(start, end []byte) sdk.Iterator {
+	return &tokenContractIterator{Iterator: s.KVStore.Iterator(start, end), store: s.KVStore, end: end}
+}
+
+type tokenContractIterator struct {
+	sdk.Iterator
+	store sdk.KVStore
+	end   []byte
+	done  bool
+}
+
+func (it *tokenContractIterator) Valid() bool {
+	return !it.done && it.Iterator.Valid()
+}
+
+// Next skips the remaining entries of the current token contract
+func (it *tokenContractIterator) Next() {
+	_, next := prefixRange(it.Key()[:gethcommon.AddressLength])
+	if next == nil {
+		it.done = true
+		return
+	}
+	it.Iterator.Close()
+	it.Iterator = it.store.Iterator(next, it.end)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // AddToOutgoingPool
@@ -54,7 +55,7 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 	// construct outgoing tx
 	outgoing := &types.OutgoingTx{
 		Sender:    sender.String(),
-		DestAddr:  counterpartReceiver,
+		DestAddr:  types.NormalizeEthAddress(counterpartReceiver),
 		Amount:    amount,
 		BridgeFee: fee,
 	}
@@ -159,10 +160,40 @@ func (k Keeper) removePoolEntry(ctx sdk.Context, id uint64) {
 	store.Delete(types.GetOutgoingTxPoolKey(id))
 }
 
+// IterateOutgoingPool iterates over all transactions in the outgoing pool by id, batched or not
+func (k Keeper) IterateOutgoingPool(ctx sdk.Context, cb func(uint64, *types.OutgoingTx) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tx types.OutgoingTx
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tx)
+		// cb returns true to stop early
+		if cb(sdk.BigEndianToUint64(iter.Key()), &tx) {
+			return
+		}
+	}
+}
+
+// IterateOutgoingPoolFeeIndex iterates over the ids of the unbatched transactions of all tokens
+func (k Keeper) IterateOutgoingPoolFeeIndex(ctx sdk.Context, cb func([]uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ids types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ids)
+		// cb returns true to stop early
+		if cb(ids.Ids) {
+			return
+		}
+	}
+}
+
 // IterateOutgoingPoolByFee itetates over the outgoing pool which is sorted by fee
 func (k Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, contract string, cb func(uint64, *types.OutgoingTx) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
-	iter := prefixStore.ReverseIterator(prefixRange([]byte(contract)))
+	iter := prefixStore.ReverseIterator(prefixRange(types.EthAddressBytes(contract)))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ids types.IDSet
@@ -181,60 +212,136 @@ func (k Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, contract string, cb fu
 	return
 }
 
-// CreateBatchFees iterates over the outgoing pool and create batch token fee map
-func (k Keeper) CreateBatchFees(ctx sdk.Context) (batchFees []*types.BatchFees) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+// setTransferRecord records the outcome of a transfer that left the outgoing pool and indexes
+// it by the height it was recorded at
+func (k Keeper) setTransferRecord(ctx sdk.Context, record *types.TransferRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTransferRecordKey(record.TxId), k.cdc.MustMarshalBinaryBare(record))
+	store.Set(types.GetTransferRecordByHeightKey(record.Height, record.TxId), []byte{})
+}
+
+// GetTransferRecord returns the outcome of a transfer that left the outgoing pool, nil while
+// the transfer is still pending
+func (k Keeper) GetTransferRecord(ctx sdk.Context, id uint64) *types.TransferRecord {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTransferRecordKey(id))
+	if bz == nil {
+		return nil
+	}
+	var record types.TransferRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return &record
+}
+
+// IterateTransferRecords iterates through the outcomes of the transfers that left the outgoing pool by id
+func (k Keeper) IterateTransferRecords(ctx sdk.Context, cb func(*types.TransferRecord) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTransferRecord)
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.TransferRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		// cb returns true to stop early
+		if cb(&record) {
+			return
+		}
+	}
+}
 
-	batchFeesMap := make(map[string]*types.BatchFees)
-	txCountMap := make(map[string]int)
+// PruneTransferRecords removes the records of transfers recorded more than the retention
+// period ago, the status of a pruned transfer is unknown
+func (k Keeper) PruneTransferRecords(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	retention := k.GetParams(ctx).TransferRecordRetention
+	if height <= retention {
+		return
+	}
 
+	store := ctx.KVStore(k.storeKey)
+	// records at the cutoff height and above are kept, the index is ordered by height
+	iter := store.Iterator(types.KeyTransferRecordByHeight, types.GetTransferRecordByHeightKey(height-retention, 0))
+	var expired [][]byte
 	for ; iter.Valid(); iter.Next() {
-		var ids types.IDSet
-		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ids)
-
-		// create a map to store the token contract address and its total fee
-		// Parse the iterator key to get contract address & fee
-		// If len(ids.Ids) > 1, multiply fee amount with len(ids.Ids) and add it to total fee amount
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+	for _, key := range expired {
+		id := types.UInt64FromBytes(key[len(types.KeyTransferRecordByHeight)+8:])
+		store.Delete(types.GetTransferRecordKey(id))
+		store.Delete(key)
+	}
+}
 
-		key := iter.Key()
-		tokenContractBytes := key[:types.ETHContractAddressLen]
-		tokenContractAddr := string(tokenContractBytes)
+// GetTransferStatus returns the stage of a transfer, pending transfers are looked up in the
+// batches of their token contract
+func (k Keeper) GetTransferStatus(ctx sdk.Context, id uint64) (*types.QueryTransferStatusResponse, error) {
+	if record := k.GetTransferRecord(ctx, id); record != nil {
+		return &types.QueryTransferStatusResponse{
+			State:      record.State,
+			BatchNonce: record.BatchNonce,
+			EventNonce: record.EventNonce,
+		}, nil
+	}
 
-		feeAmountBytes := key[len(tokenContractBytes):]
-		feeAmount := big.NewInt(0).SetBytes(feeAmountBytes)
+	tx, err := k.getPoolEntry(ctx, id)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknown, "tx id")
+	}
+	_, tokenContract, err := k.DenomToERC20(ctx, tx.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
 
-		for i := 0; i < len(ids.Ids); i++ {
-			if txCountMap[tokenContractAddr] >= OutgoingTxBatchSize {
-				break
-			} else {
-				// add fee amount
-				if _, ok := batchFeesMap[tokenContractAddr]; ok {
-					batchFeesMap[tokenContractAddr].TopOneHundred = batchFeesMap[tokenContractAddr].TopOneHundred.Add(sdk.NewIntFromBigInt(feeAmount))
-				} else {
-					batchFeesMap[tokenContractAddr] = &types.BatchFees{
-						Token:         tokenContractAddr,
-						TopOneHundred: sdk.NewIntFromBigInt(feeAmount)}
-				}
-
-				txCountMap[tokenContractAddr] = txCountMap[tokenContractAddr] + 1
+	res := &types.QueryTransferStatusResponse{State: types.TRANSFER_STATE_IN_POOL}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutgoingTxBatchContractPrefix(tokenContract))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var batch types.OutgoingTxBatch
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &batch)
+		for _, batchTx := range batch.Transactions {
+			if batchTx.Id == id {
+				res.State = types.TRANSFER_STATE_IN_BATCH
+				res.BatchNonce = batch.BatchNonce
+				res.BatchTimeout = batch.BatchTimeout
+				return res, nil
 			}
 		}
 	}
+	return res, nil
+}
 
-	// create array of batchFees
-	for _, batchFee := range batchFeesMap {
-		// newBatchFee := types.BatchFees{
-		// 	Token:         batchFee.Token,
-		// 	TopOneHundred: batchFee.TopOneHundred,
-		// }
-		batchFees = append(batchFees, batchFee)
+// CreateBatchFees iterates over the outgoing pool and returns the fees of every token contract,
+// ordered by token contract
+func (k Keeper) CreateBatchFees(ctx sdk.Context) (batchFees []*types.BatchFees) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := tokenContractStore{prefixStore}.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		batchFees = append(batchFees, k.getBatchFees(ctx, iter.Key()[:gethcommon.AddressLength]))
 	}
-
 	return
 }
 
+// getBatchFees sums the fees of the first OutgoingTxBatchSize txs of a token contract in the fee index
+func (k Keeper) getBatchFees(ctx sdk.Context, tokenContract []byte) *types.BatchFees {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := prefixStore.Iterator(prefixRange(tokenContract))
+	defer iter.Close()
+
+	batchFees := &types.BatchFees{Token: types.EthAddressFromBytes(tokenContract), TopOneHundred: sdk.ZeroInt()}
+	var txCount int
+	for ; iter.Valid() && txCount < OutgoingTxBatchSize; iter.Next() {
+		var ids types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ids)
+		feeAmount := sdk.NewIntFromBigInt(big.NewInt(0).SetBytes(iter.Key()[len(tokenContract):]))
+		for i := 0; i < len(ids.Ids) && txCount < OutgoingTxBatchSize; i++ {
+			batchFees.TopOneHundred = batchFees.TopOneHundred.Add(feeAmount)
+			txCount++
+		}
+	}
+	return batchFees
+}
+
 func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(idKey)
@@ -246,3 +353,20 @@ func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	store.Set(idKey, bz)
 	return id
 }
+
+// getLastID returns the last id handed out by autoIncrementID for the key
+func (k Keeper) getLastID(ctx sdk.Context, idKey []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(idKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz) - 1
+}
+
+// setLastID sets the last id handed out by autoIncrementID for the key
+func (k Keeper) setLastID(ctx sdk.Context, idKey []byte, id uint64) {
+	if id == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(idKey, sdk.Uint64ToBigEndian(id+1))
+}
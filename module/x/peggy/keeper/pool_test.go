@@ -6,6 +6,7 @@ import (
 
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,4 +134,92 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	assert.Equal(t, batchFees[0].TopOneHundred.BigInt(), big.NewInt(int64(8)))
 	assert.Equal(t, batchFees[1].TopOneHundred.BigInt(), big.NewInt(int64(500)))
 
+	// the query pages over the token contracts
+	c := sdk.WrapSDKContext(ctx)
+	page, err := input.PeggyKeeper.BatchFees(c, &types.QueryBatchFeeRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.BatchFees{batchFees[0]}, page.BatchFees)
+	require.NotNil(t, page.Pagination.NextKey)
+	page, err = input.PeggyKeeper.BatchFees(c, &types.QueryBatchFeeRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.BatchFees{batchFees[1]}, page.BatchFees)
+	assert.Nil(t, page.Pagination.NextKey)
+}
+
+func TestTransferStatus(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(99999, myTokenContractAddr).PeggyCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+
+	// the response carries the id of the transfer
+	msgServer := NewMsgServerImpl(input.PeggyKeeper)
+	var ids []uint64
+	for _, fee := range []uint64{2, 1} {
+		res, err := msgServer.SendToEth(sdk.WrapSDKContext(ctx), &types.MsgSendToEth{
+			Sender:    mySender.String(),
+			EthDest:   myReceiver,
+			Amount:    types.NewERC20Token(100, myTokenContractAddr).PeggyCoin(),
+			BridgeFee: types.NewERC20Token(fee, myTokenContractAddr).PeggyCoin(),
+		})
+		require.NoError(t, err)
+		ids = append(ids, res.TxId)
+	}
+	assert.Equal(t, []uint64{1, 2}, ids)
+
+	status, err := input.PeggyKeeper.GetTransferStatus(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryTransferStatusResponse{State: types.TRANSFER_STATE_IN_POOL}, status)
+
+	// only the higher fee tx is batched
+	batch, err := input.PeggyKeeper.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 1)
+	require.NoError(t, err)
+	status, err = input.PeggyKeeper.GetTransferStatus(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryTransferStatusResponse{
+		State:        types.TRANSFER_STATE_IN_BATCH,
+		BatchNonce:   batch.BatchNonce,
+		BatchTimeout: batch.BatchTimeout,
+	}, status)
+	status, err = input.PeggyKeeper.GetTransferStatus(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, types.TRANSFER_STATE_IN_POOL, status.State)
+
+	// executed transfers keep their record after leaving the pool
+	require.NoError(t, input.PeggyKeeper.OutgoingTxBatchExecuted(ctx, myTokenContractAddr, batch.BatchNonce, 7))
+	_, err = input.PeggyKeeper.getPoolEntry(ctx, 1)
+	require.Error(t, err)
+	status, err = input.PeggyKeeper.GetTransferStatus(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryTransferStatusResponse{
+		State:      types.TRANSFER_STATE_EXECUTED,
+		BatchNonce: batch.BatchNonce,
+		EventNonce: 7,
+	}, status)
+
+	// records are kept for the retention period
+	retention := int64(input.PeggyKeeper.GetParams(ctx).TransferRecordRetention)
+	executed := ctx.BlockHeight()
+	ctx = ctx.WithBlockHeight(executed + retention)
+	input.PeggyKeeper.PruneTransferRecords(ctx)
+	assert.NotNil(t, input.PeggyKeeper.GetTransferRecord(ctx, 1))
+
+	ctx = ctx.WithBlockHeight(executed + retention + 1)
+	input.PeggyKeeper.PruneTransferRecords(ctx)
+	assert.Nil(t, input.PeggyKeeper.GetTransferRecord(ctx, 1))
+	_, err = input.PeggyKeeper.GetTransferStatus(ctx, 1)
+	assert.True(t, types.ErrUnknown.Is(err))
+	index := sdk.KVStorePrefixIterator(ctx.KVStore(input.PeggyKeeper.storeKey), types.KeyTransferRecordByHeight)
+	defer index.Close()
+	assert.False(t, index.Valid())
+
+	_, err = input.PeggyKeeper.GetTransferStatus(ctx, 3)
+	assert.True(t, types.ErrUnknown.Is(err))
 }
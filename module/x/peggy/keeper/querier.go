@@ -73,6 +73,15 @@ const (
 	// Used by the relayer to package a logic call with signatures required
 	// to submit to Ethereum
 	QueryLogicCallConfirms = "logicCallConfirms"
+
+	// Delegate keys
+	// resolve any of a validator, its orchestrator or its eth address
+	// to the other two, used by orchestrators to check their setup on
+	// startup and by monitoring
+
+	QueryDelegateKeysByValidator    = "delegateKeysByValidator"
+	QueryDelegateKeysByOrchestrator = "delegateKeysByOrchestrator"
+	QueryDelegateKeysByEthAddress   = "delegateKeysByEthAddress"
 )
 
 // NewQuerier is the module level router for state queries
@@ -114,6 +123,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case QueryOutgoingLogicCalls:
 			return lastLogicCallRequests(ctx, keeper)
 
+		// Delegate keys
+		case QueryDelegateKeysByValidator:
+			return queryDelegateKeysByValidator(ctx, path[1], keeper)
+		case QueryDelegateKeysByOrchestrator:
+			return queryDelegateKeysByOrchestrator(ctx, path[1], keeper)
+		case QueryDelegateKeysByEthAddress:
+			return queryDelegateKeysByEthAddress(ctx, path[1], keeper)
+
 		case QueryPeggyID:
 			return queryPeggyID(ctx, keeper)
 
@@ -446,3 +463,39 @@ func queryPeggyID(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 		return res, nil
 	}
 }
+
+func queryDelegateKeysByValidator(ctx sdk.Context, valAddr string, keeper Keeper) ([]byte, error) {
+	keys, err := keeper.DelegateKeysByValidator(sdk.WrapSDKContext(ctx), &types.QueryDelegateKeysByValidatorRequest{ValidatorAddress: valAddr})
+	if err != nil {
+		return nil, err
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, keys)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryDelegateKeysByOrchestrator(ctx sdk.Context, orchAddr string, keeper Keeper) ([]byte, error) {
+	keys, err := keeper.DelegateKeysByOrchestrator(sdk.WrapSDKContext(ctx), &types.QueryDelegateKeysByOrchestratorRequest{OrchestratorAddress: orchAddr})
+	if err != nil {
+		return nil, err
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, keys)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryDelegateKeysByEthAddress(ctx sdk.Context, ethAddr string, keeper Keeper) ([]byte, error) {
+	keys, err := keeper.DelegateKeysByEthAddress(sdk.WrapSDKContext(ctx), &types.QueryDelegateKeysByEthAddressRequest{EthAddress: ethAddr})
+	if err != nil {
+		return nil, err
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, keys)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"github.com/althea-net/peggy/module/x/peggy/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -562,7 +565,7 @@ func TestQueryAllBatchConfirms(t *testing.T) {
 	batchConfirms, err := queryAllBatchConfirms(ctx, "1", tokenContract, input.PeggyKeeper)
 	require.NoError(t, err)
 
-	expectedJSON := []byte(`[{"eth_signer":"0xf35e2cc8e6523d683ed44870f5b7cc785051a77d", "nonce":"1", "signature":"signature", "token_contract":"0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B", "orchestrator":"cosmos1mgamdcs9dah0vn0gqupl05up7pedg2mvupe6hh"}]`)
+	expectedJSON := []byte(`[{"eth_signer":"0xF35e2cC8E6523d683eD44870f5B7cC785051a77D", "nonce":"1", "signature":"signature", "token_contract":"0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B", "orchestrator":"cosmos1mgamdcs9dah0vn0gqupl05up7pedg2mvupe6hh"}]`)
 
 	assert.JSONEq(t, string(expectedJSON), string(batchConfirms), "json is equal")
 }
@@ -724,3 +727,152 @@ func TestQueryCurrentValset(t *testing.T) {
 	expectedValset := types.Valset{Nonce: 1234567, Height: 1234567, Members: []*types.BridgeValidator{&bridgeVal}}
 	assert.Equal(t, &expectedValset, currentValset)
 }
+
+func TestQueryDelegateKeys(t *testing.T) {
+	var (
+		ethAddress                 = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		orchAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		valAddress  sdk.ValAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+		unknownVal  sdk.ValAddress = bytes.Repeat([]byte{0x3}, sdk.AddrLen)
+	)
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	k.SetOrchestratorValidator(ctx, valAddress, orchAddress)
+	k.SetEthAddress(ctx, valAddress, ethAddress)
+	k.incrementDelegateKeyNonce(ctx, valAddress)
+	c := sdk.WrapSDKContext(ctx)
+
+	byVal, err := k.DelegateKeysByValidator(c, &types.QueryDelegateKeysByValidatorRequest{ValidatorAddress: valAddress.String()})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryDelegateKeysByValidatorResponse{EthAddress: ethAddress, OrchestratorAddress: orchAddress.String()}, byVal)
+
+	byOrch, err := k.DelegateKeysByOrchestrator(c, &types.QueryDelegateKeysByOrchestratorRequest{OrchestratorAddress: orchAddress.String()})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryDelegateKeysByOrchestratorResponse{ValidatorAddress: valAddress.String(), EthAddress: ethAddress}, byOrch)
+
+	byEth, err := k.DelegateKeysByEthAddress(c, &types.QueryDelegateKeysByEthAddressRequest{EthAddress: ethAddress})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryDelegateKeysByEthAddressResponse{ValidatorAddress: valAddress.String(), OrchestratorAddress: orchAddress.String()}, byEth)
+
+	nonce, err := k.DelegateKeyNonce(c, &types.QueryDelegateKeyNonceRequest{ValidatorAddress: valAddress.String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce.Nonce)
+	nonce, err = k.DelegateKeyNonce(c, &types.QueryDelegateKeyNonceRequest{ValidatorAddress: unknownVal.String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), nonce.Nonce)
+
+	// unknown and invalid addresses are rejected
+	_, err = k.DelegateKeysByValidator(c, &types.QueryDelegateKeysByValidatorRequest{ValidatorAddress: unknownVal.String()})
+	require.Error(t, err)
+	_, err = k.DelegateKeysByOrchestrator(c, &types.QueryDelegateKeysByOrchestratorRequest{OrchestratorAddress: "not a valid addr"})
+	require.Error(t, err)
+	_, err = k.DelegateKeysByEthAddress(c, &types.QueryDelegateKeysByEthAddressRequest{EthAddress: "0x3232323232323232323232323232323232323232"})
+	require.Error(t, err)
+
+	// the legacy querier serves the same data
+	got, err := queryDelegateKeysByOrchestrator(ctx, orchAddress.String(), k)
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{"validator_address":"%s","eth_address":"%s"}`, valAddress, ethAddress), string(got))
+}
+
+func TestPaginatedValsetRequests(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	k.StakingKeeper = NewStakingKeeperMock(ValAddrs[0])
+	for i := 0; i < 7; i++ {
+		k.SetValsetRequest(ctx.WithBlockHeight(int64(100 + i)))
+	}
+	c := sdk.WrapSDKContext(ctx)
+	nonces := func(valsets []*types.Valset) (out []uint64) {
+		for _, v := range valsets {
+			out = append(out, v.Nonce)
+		}
+		return
+	}
+
+	// without pagination the latest five are returned
+	res, err := k.LastValsetRequests(c, &types.QueryLastValsetRequestsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{106, 105, 104, 103, 102}, nonces(res.Valsets))
+
+	// pages continue from the next key, newest first
+	res, err = k.LastValsetRequests(c, &types.QueryLastValsetRequestsRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{106, 105, 104}, nonces(res.Valsets))
+	assert.Equal(t, uint64(7), res.Pagination.Total)
+	res, err = k.LastValsetRequests(c, &types.QueryLastValsetRequestsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{103, 102, 101}, nonces(res.Valsets))
+	res, err = k.LastValsetRequests(c, &types.QueryLastValsetRequestsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{100}, nonces(res.Valsets))
+	assert.Nil(t, res.Pagination.NextKey)
+
+	// offsets count from the newest
+	res, err = k.LastValsetRequests(c, &types.QueryLastValsetRequestsRequest{Pagination: &query.PageRequest{Offset: 5, Limit: 3}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{101, 100}, nonces(res.Valsets))
+}
+
+func TestQueryAttestations(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.PeggyKeeper
+	c := sdk.WrapSDKContext(ctx)
+	attest := func(claim types.EthereumClaim, height uint64, observed bool, voters ...sdk.ValAddress) []byte {
+		any, err := codectypes.NewAnyWithValue(claim.(proto.Message))
+		require.NoError(t, err)
+		att := &types.Attestation{Observed: observed, Height: height, Claim: any}
+		for _, v := range voters {
+			att.Votes = append(att.Votes, v.String())
+		}
+		k.SetAttestation(ctx, claim.GetEventNonce(), claim.ClaimHash(), att)
+		return claim.ClaimHash()
+	}
+	deposit := func(nonce uint64, receiver sdk.AccAddress) *types.MsgDepositClaim {
+		return &types.MsgDepositClaim{EventNonce: nonce, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Amount: sdk.NewInt(1), EthereumSender: EthAddrs[0].String(), CosmosReceiver: receiver.String()}
+	}
+	observedHash := attest(deposit(1, AccAddrs[0]), 10, true, ValAddrs[0], ValAddrs[1], ValAddrs[2], ValAddrs[3])
+	pendingHash := attest(deposit(2, AccAddrs[0]), 11, false, ValAddrs[0], ValAddrs[1])
+	conflictHash := attest(deposit(2, AccAddrs[1]), 12, false, ValAddrs[2])
+	attest(&types.MsgWithdrawClaim{EventNonce: 3, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", BatchNonce: 1}, 13, false, ValAddrs[3])
+	claimHashes := func(entries []types.AttestationEntry) (out [][]byte) {
+		for _, e := range entries {
+			out = append(out, e.ClaimHash)
+		}
+		return
+	}
+
+	// filters
+	res, err := k.Attestations(c, &types.QueryAttestationsRequest{Observed: types.OBSERVATION_FILTER_OBSERVED})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{observedHash}, claimHashes(res.Attestations))
+	res, err = k.Attestations(c, &types.QueryAttestationsRequest{Observed: types.OBSERVATION_FILTER_PENDING, ClaimType: types.CLAIM_TYPE_DEPOSIT})
+	require.NoError(t, err)
+	assert.Len(t, res.Attestations, 2)
+	res, err = k.Attestations(c, &types.QueryAttestationsRequest{MinHeight: 11, MaxHeight: 12, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	assert.Len(t, res.Attestations, 1)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.True(t, res.Attestations[0].Attestation.Claim.GetCachedValue() != nil)
+
+	// both claims made for one nonce
+	byNonce, err := k.AttestationsByNonce(c, &types.QueryAttestationsByNonceRequest{EventNonce: 2})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, [][]byte{pendingHash, conflictHash}, claimHashes(byNonce.Attestations))
+
+	// two of five equally powerful validators voted
+	votes, err := k.AttestationVotes(c, &types.QueryAttestationVotesRequest{EventNonce: 2, ClaimHash: pendingHash})
+	require.NoError(t, err)
+	assert.Equal(t, []types.AttestationVote{{Validator: ValAddrs[0].String(), Power: 10}, {Validator: ValAddrs[1].String(), Power: 10}}, votes.Votes)
+	assert.False(t, votes.Observed)
+	assert.Equal(t, sdk.NewInt(20), votes.VotedPower)
+	assert.Equal(t, sdk.NewInt(50), votes.TotalPower)
+	assert.Equal(t, sdk.NewInt(33), votes.RequiredPower)
+	assert.Equal(t, sdk.NewDec(40), votes.VotedPercentage)
+	assert.Equal(t, sdk.NewInt(66), votes.ThresholdPercentage)
+
+	_, err = k.AttestationVotes(c, &types.QueryAttestationVotesRequest{EventNonce: 3, ClaimHash: pendingHash})
+	assert.Error(t, err)
+}
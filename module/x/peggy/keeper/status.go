@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// GetBridgeStatus summarizes the progress of the oracle and of the outgoing queues, along with
+// the bonded validators that fall behind on observing events or on signing the latest valset
+// and batches
+func (k Keeper) GetBridgeStatus(ctx sdk.Context) types.BridgeStatus {
+	status := types.BridgeStatus{
+		LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx),
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+	}
+
+	// valsets are iterated newest first
+	var latestValset *types.Valset
+	k.IterateValsets(ctx, func(_ []byte, valset *types.Valset) bool {
+		latestValset = valset
+		return true
+	})
+	if latestValset != nil {
+		status.LatestValsetNonce = latestValset.Nonce
+		if height := uint64(ctx.BlockHeight()); height > latestValset.Height {
+			status.LatestValsetAge = height - latestValset.Height
+		}
+	}
+
+	tokens := make(map[string]*types.TokenStatus)
+	tokenStatus := func(tokenContract string) *types.TokenStatus {
+		if _, ok := tokens[tokenContract]; !ok {
+			tokens[tokenContract] = &types.TokenStatus{TokenContract: tokenContract}
+		}
+		return tokens[tokenContract]
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ids types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ids)
+		tokenStatus(types.EthAddressFromBytes(iter.Key()[:gethcommon.AddressLength])).PendingTxs += uint64(len(ids.Ids))
+	}
+	// batches are iterated newest first by token contract, the first one of a token is its latest
+	var latestBatches []*types.OutgoingTxBatch
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		token := tokenStatus(batch.TokenContract)
+		if token.PendingBatches == 0 {
+			token.LatestBatchNonce = batch.BatchNonce
+			latestBatches = append(latestBatches, batch)
+		}
+		token.PendingBatches++
+		return false
+	})
+	for _, token := range tokens {
+		status.Tokens = append(status.Tokens, *token)
+	}
+	sort.Slice(status.Tokens, func(i, j int) bool {
+		return status.Tokens[i].TokenContract < status.Tokens[j].TokenContract
+	})
+
+	var valsetConfirms []*types.MsgValsetConfirm
+	if latestValset != nil {
+		valsetConfirms = k.GetValsetConfirms(ctx, latestValset.Nonce)
+	}
+	batchConfirms := make([][]types.MsgConfirmBatch, len(latestBatches))
+	for i, batch := range latestBatches {
+		batchConfirms[i] = k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
+	}
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		valAddr := val.GetOperator()
+		// the nonce a validator without claims would start at is not its progress, it has no lag
+		lastEventNonce, found := k.getStoredLastEventNonceByValidator(ctx, valAddr)
+		valStatus := types.ValidatorStatus{
+			Validator:      valAddr.String(),
+			LastEventNonce: lastEventNonce,
+			NoClaims:       !found,
+		}
+		if found && valStatus.LastEventNonce < status.LastObservedEventNonce {
+			valStatus.EventNonceLag = status.LastObservedEventNonce - valStatus.LastEventNonce
+		}
+		valStatus.MissingValsetConfirm = latestValset != nil && k.isLiable(ctx, valAddr, latestValset.Height) &&
+			!k.HasSignedValset(ctx, valAddr, latestValset, valsetConfirms)
+		for i, batch := range latestBatches {
+			if k.isLiable(ctx, valAddr, batch.Block) && !k.HasSignedBatch(ctx, valAddr, batch, batchConfirms[i]) {
+				valStatus.MissingBatchConfirms = append(valStatus.MissingBatchConfirms, batch.TokenContract)
+			}
+		}
+		status.Validators = append(status.Validators, valStatus)
+	}
+	return status
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBridgeStatus(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.PeggyKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).PeggyCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SetBalances(ctx, mySender, allVouchers))
+	for i := 0; i < 5; i++ {
+		amount := types.NewERC20Token(uint64(i+100), myTokenContractAddr).PeggyCoin()
+		fee := types.NewERC20Token(uint64(i+1), myTokenContractAddr).PeggyCoin()
+		_, err := k.AddToOutgoingPool(ctx, mySender, myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+	_, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.NoError(t, err)
+	batch, err := k.BuildOutgoingTXBatch(ctx, myTokenContractAddr, 2)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	valset := k.SetValsetRequest(ctx)
+	ctx = ctx.WithBlockHeight(14)
+
+	// the first validator signed everything and is up to date, the others lag behind
+	k.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{Nonce: valset.Nonce, Orchestrator: AccAddrs[0].String(), EthAddress: EthAddrs[0].String()})
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{Nonce: batch.BatchNonce, TokenContract: myTokenContractAddr, Orchestrator: AccAddrs[0].String()})
+	k.setLastObservedEventNonce(ctx, 3)
+	k.setLastEventNonceByValidator(ctx, ValAddrs[0], 3)
+	k.setLastEventNonceByValidator(ctx, ValAddrs[1], 1)
+
+	// when
+	status := k.GetBridgeStatus(ctx)
+
+	// then
+	assert.Equal(t, uint64(3), status.LastObservedEventNonce)
+	assert.Equal(t, valset.Nonce, status.LatestValsetNonce)
+	assert.Equal(t, uint64(4), status.LatestValsetAge)
+	assert.Equal(t, []types.TokenStatus{{TokenContract: myTokenContractAddr, PendingTxs: 1, PendingBatches: 2, LatestBatchNonce: batch.BatchNonce}}, status.Tokens)
+	require.Len(t, status.Validators, 5)
+	byValidator := make(map[string]types.ValidatorStatus)
+	for _, v := range status.Validators {
+		byValidator[v.Validator] = v
+	}
+	assert.Equal(t, types.ValidatorStatus{Validator: ValAddrs[0].String(), LastEventNonce: 3}, byValidator[ValAddrs[0].String()])
+	assert.Equal(t, types.ValidatorStatus{
+		Validator:            ValAddrs[1].String(),
+		LastEventNonce:       1,
+		EventNonceLag:        2,
+		MissingValsetConfirm: true,
+		MissingBatchConfirms: []string{myTokenContractAddr},
+	}, byValidator[ValAddrs[1].String()])
+	// a validator that never submitted a claim is not reported as nearly up to date
+	assert.Equal(t, types.ValidatorStatus{
+		Validator:            ValAddrs[2].String(),
+		MissingValsetConfirm: true,
+		MissingBatchConfirms: []string{myTokenContractAddr},
+		NoClaims:             true,
+	}, byValidator[ValAddrs[2].String()])
+}
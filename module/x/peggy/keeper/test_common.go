@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/althea-net/peggy/module/x/peggy/migrations"
 	"github.com/althea-net/peggy/module/x/peggy/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -35,6 +36,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
@@ -49,6 +52,7 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -178,20 +182,24 @@ var (
 
 	// TestingPeggyParams is a set of peggy params for testing
 	TestingPeggyParams = types.Params{
-		PeggyId:                       "testpeggyid",
-		ContractSourceHash:            "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:         "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                 11,
-		SignedBatchesWindow:           10,
-		SignedValsetsWindow:           10,
-		SignedClaimsWindow:            10,
-		TargetBatchTimeout:            60001,
-		AverageBlockTime:              5000,
-		AverageEthereumBlockTime:      15000,
-		SlashFractionValset:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:            sdk.NewDecWithPrec(1, 2),
-		SlashFractionClaim:            sdk.NewDecWithPrec(1, 2),
-		SlashFractionConflictingClaim: sdk.NewDecWithPrec(1, 2),
+		PeggyId:                        "testpeggyid",
+		ContractSourceHash:             "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:          "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                  11,
+		SignedBatchesWindow:            10,
+		SignedValsetsWindow:            10,
+		SignedClaimsWindow:             10,
+		TargetBatchTimeout:             60001,
+		AverageBlockTime:               5000,
+		AverageEthereumBlockTime:       15000,
+		SlashFractionValset:            sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:             sdk.NewDecWithPrec(1, 2),
+		SlashFractionClaim:             sdk.NewDecWithPrec(1, 2),
+		SlashFractionConflictingClaim:  sdk.NewDecWithPrec(1, 2),
+		DelegateKeyRotationGracePeriod: 10,
+		IbcForwardTimeout:              60000,
+		DepositReceiptRetention:        100,
+		TransferRecordRetention:        100,
 	}
 )
 
@@ -203,6 +211,8 @@ type TestInput struct {
 	DistKeeper    distrkeeper.Keeper
 	BankKeeper    bankkeeper.BaseKeeper
 	GovKeeper     govkeeper.Keeper
+	IBCKeeper     *IBCTransferKeeperMock
+	PeggyStoreKey sdk.StoreKey
 	Context       sdk.Context
 	Marshaler     codec.Marshaler
 	LegacyAmino   *codec.LegacyAmino
@@ -336,7 +346,6 @@ func CreateTestEnv(t *testing.T) TestInput {
 
 	distKeeper := distrkeeper.NewKeeper(marshaler, keyDistro, getSubspace(paramsKeeper, distrtypes.ModuleName), accountKeeper, bankKeeper, stakingKeeper, authtypes.FeeCollectorName, nil)
 	distKeeper.SetParams(ctx, distrtypes.DefaultParams())
-	stakingKeeper.SetHooks(distKeeper.Hooks())
 
 	// set genesis items required for distribution
 	distKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
@@ -383,8 +392,12 @@ func CreateTestEnv(t *testing.T) TestInput {
 	govKeeper.SetVotingParams(ctx, govtypes.DefaultVotingParams())
 	govKeeper.SetTallyParams(ctx, govtypes.DefaultTallyParams())
 
-	k := NewKeeper(marshaler, peggyKey, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper)
+	ibcKeeper := NewIBCTransferKeeperMock(bankKeeper)
 
+	k := NewKeeper(marshaler, peggyKey, getSubspace(paramsKeeper, types.DefaultParamspace), &stakingKeeper, bankKeeper, ibcKeeper)
+	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(distKeeper.Hooks(), k.Hooks()))
+
+	migrations.SetStoreVersion(ctx, peggyKey, types.ConsensusVersion)
 	k.SetParams(ctx, TestingPeggyParams)
 
 	return TestInput{
@@ -394,6 +407,8 @@ func CreateTestEnv(t *testing.T) TestInput {
 		StakingKeeper: stakingKeeper,
 		DistKeeper:    distKeeper,
 		GovKeeper:     govKeeper,
+		IBCKeeper:     ibcKeeper,
+		PeggyStoreKey: peggyKey,
 		Context:       ctx,
 		Marshaler:     marshaler,
 		LegacyAmino:   cdc,
@@ -439,6 +454,78 @@ func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAd
 	return coin
 }
 
+// IBCTransferKeeperMock is a mock IBC transfer keeper for use in the tests
+type IBCTransferKeeperMock struct {
+	DenomTraces map[string]ibctransfertypes.DenomTrace
+	// Transfers are the ICS-20 transfers sent so far
+	Transfers []ibctransfertypes.FungibleTokenPacketData
+	// SendTransferErr is returned by SendTransfer if set
+	SendTransferErr error
+
+	bankKeeper bankkeeper.Keeper
+}
+
+// NewIBCTransferKeeperMock creates a new mock IBC transfer keeper that escrows the transfers it sends
+func NewIBCTransferKeeperMock(bankKeeper bankkeeper.Keeper) *IBCTransferKeeperMock {
+	return &IBCTransferKeeperMock{
+		DenomTraces: make(map[string]ibctransfertypes.DenomTrace),
+		bankKeeper:  bankKeeper,
+	}
+}
+
+// SetDenomTrace registers a denom trace and returns the IBC denom of it
+func (m *IBCTransferKeeperMock) SetDenomTrace(trace ibctransfertypes.DenomTrace) string {
+	m.DenomTraces[trace.Hash().String()] = trace
+	return trace.IBCDenom()
+}
+
+// GetDenomTrace returns a registered denom trace
+func (m *IBCTransferKeeperMock) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	trace, ok := m.DenomTraces[denomTraceHash.String()]
+	return trace, ok
+}
+
+// SendTransfer escrows the token and records the transfer
+func (m *IBCTransferKeeperMock) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	if m.SendTransferErr != nil {
+		return m.SendTransferErr
+	}
+	escrow := ibctransfertypes.GetEscrowAddress(sourcePort, sourceChannel)
+	if err := m.bankKeeper.SendCoins(ctx, sender, escrow, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+	m.Transfers = append(m.Transfers, ibctransfertypes.NewFungibleTokenPacketData(
+		token.Denom, token.Amount.Uint64(), sender.String(), receiver,
+	))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		ibctransfertypes.EventTypeTransfer,
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyReceiver, receiver),
+	))
+	return nil
+}
+
+// Refund returns the escrowed token of a transfer to its sender, as ICS-20 does when the
+// packet times out or is acknowledged with an error
+func (m *IBCTransferKeeperMock) Refund(ctx sdk.Context, channel string, i int) error {
+	transfer := m.Transfers[i]
+	sender, err := sdk.AccAddressFromBech32(transfer.Sender)
+	if err != nil {
+		return err
+	}
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, channel)
+	return m.bankKeeper.SendCoins(ctx, escrow, sender, sdk.NewCoins(sdk.NewCoin(transfer.Denom, sdk.NewIntFromUint64(transfer.Amount))))
+}
+
 // NewStakingKeeperMock creates a new mock staking keeper
 func NewStakingKeeperMock(operators ...sdk.ValAddress) *StakingKeeperMock {
 	r := &StakingKeeperMock{
@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	v2 "github.com/althea-net/peggy/module/x/peggy/migrations/v2"
+	v3 "github.com/althea-net/peggy/module/x/peggy/migrations/v3"
+	v4 "github.com/althea-net/peggy/module/x/peggy/migrations/v4"
+	v5 "github.com/althea-net/peggy/module/x/peggy/migrations/v5"
+	v6 "github.com/althea-net/peggy/module/x/peggy/migrations/v6"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Migration converts the peggy store from the previous consensus version
+type Migration func(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace, bankKeeper types.BankKeeper) error
+
+// migrations holds the migrations by the consensus version they migrate the store to
+var migrations = map[uint64]Migration{
+	2: v2.MigrateStore,
+	3: v3.MigrateStore,
+	4: v4.MigrateStore,
+	5: v5.MigrateStore,
+	6: v6.MigrateStore,
+}
+
+// GetStoreVersion returns the consensus version of the store, stores written before
+// versioning was introduced are at version 1
+func GetStoreVersion(ctx sdk.Context, storeKey sdk.StoreKey) uint64 {
+	bz := ctx.KVStore(storeKey).Get(types.KeyStoreVersion)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// SetStoreVersion sets the consensus version of the store
+func SetStoreVersion(ctx sdk.Context, storeKey sdk.StoreKey, version uint64) {
+	ctx.KVStore(storeKey).Set(types.KeyStoreVersion, types.UInt64Bytes(version))
+}
+
+// Migrate runs the migrations from the version of the store up to the current consensus version
+func Migrate(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace, bankKeeper types.BankKeeper) error {
+	version := GetStoreVersion(ctx, storeKey)
+	if version > types.ConsensusVersion {
+		return sdkerrors.Wrapf(types.ErrUnsupported, "store version %d is ahead of consensus version %d", version, types.ConsensusVersion)
+	}
+	for ; version < types.ConsensusVersion; version++ {
+		migrate, ok := migrations[version+1]
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrUnsupported, "no migration to version %d", version+1)
+		}
+		if err := migrate(ctx, storeKey, cdc, paramSpace, bankKeeper); err != nil {
+			return sdkerrors.Wrapf(err, "migrate to version %d", version+1)
+		}
+		SetStoreVersion(ctx, storeKey, version+1)
+	}
+	return nil
+}
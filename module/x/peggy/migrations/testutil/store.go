@@ -0,0 +1,72 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// TestStore is a peggy store and param subspace for migration tests
+type TestStore struct {
+	Context    sdk.Context
+	StoreKey   sdk.StoreKey
+	Marshaler  codec.BinaryMarshaler
+	ParamSpace paramstypes.Subspace
+}
+
+// CreateTestStore returns an empty peggy store and param subspace
+func CreateTestStore(t *testing.T) TestStore {
+	t.Helper()
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.TestingLogger())
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	marshaler := codec.NewProtoCodec(registry)
+	paramsKeeper := paramskeeper.NewKeeper(marshaler, codec.NewLegacyAmino(), keyParams, tkeyParams)
+	return TestStore{
+		Context:    ctx,
+		StoreKey:   storeKey,
+		Marshaler:  marshaler,
+		ParamSpace: paramsKeeper.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Load writes the entries to the peggy store
+func (s TestStore) Load(entries map[string][]byte) {
+	store := s.Context.KVStore(s.StoreKey)
+	for k, v := range entries {
+		store.Set([]byte(k), v)
+	}
+}
+
+// Contents returns all entries of the peggy store
+func (s TestStore) Contents() map[string][]byte {
+	out := make(map[string][]byte)
+	iter := s.Context.KVStore(s.StoreKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out[string(iter.Key())] = iter.Value()
+	}
+	return out
+}
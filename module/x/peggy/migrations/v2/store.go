@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// the key layout of version 2, later versions may change it
+var (
+	// ethAddressKey indexes the eth address by validator
+	ethAddressKey = []byte{0x1}
+
+	// orchestratorAddressKey indexes the validator by orchestrator
+	orchestratorAddressKey = []byte{0xe8}
+
+	// validatorOrchestratorKey indexes the orchestrator by validator, it is new in version 2
+	validatorOrchestratorKey = []byte{0xe9}
+
+	// ethAddressValidatorKey indexes the validator by eth address, it is new in version 2
+	ethAddressValidatorKey = []byte{0xea}
+
+	// outgoingLogicCallKey indexes the logic calls by invalidation id and nonce
+	outgoingLogicCallKey = []byte{0xde}
+)
+
+// MigrateStore migrates the peggy store from version 1 to version 2
+//   - backfills the validator to orchestrator and eth address to validator indexes
+//   - sets the creation height of logic calls stored without one to the upgrade height, the
+//     missing signatures of logic calls are only slashed from their creation height on
+//   - sets the params added in version 2 to their defaults
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace, _ types.BankKeeper) error {
+	store := ctx.KVStore(storeKey)
+	backfillReverseIndex(store, orchestratorAddressKey, validatorOrchestratorKey)
+	backfillReverseIndex(store, ethAddressKey, ethAddressValidatorKey)
+	if err := backfillLogicCallBlocks(ctx, store, cdc); err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	for _, p := range []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.ParamsStoreDelegateKeyRotationGracePeriod, defaults.DelegateKeyRotationGracePeriod},
+		{types.ParamsStoreIBCForwardTimeout, defaults.IbcForwardTimeout},
+	} {
+		if !paramSpace.Has(ctx, p.key) {
+			paramSpace.Set(ctx, p.key, p.value)
+		}
+	}
+	return nil
+}
+
+// backfillReverseIndex writes an entry to the reverse index for every entry of the index
+func backfillReverseIndex(store sdk.KVStore, index, reverse []byte) {
+	var entries [][2][]byte
+	iter := prefix.NewStore(store, index).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		entries = append(entries, [2][]byte{iter.Key(), iter.Value()})
+	}
+	iter.Close()
+
+	reverseStore := prefix.NewStore(store, reverse)
+	for _, e := range entries {
+		if len(e[1]) == 0 {
+			continue
+		}
+		reverseStore.Set(e[1], e[0])
+	}
+}
+
+// backfillLogicCallBlocks sets the block of the logic calls that were created before it was recorded
+func backfillLogicCallBlocks(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	var keys [][]byte
+	var calls []*types.OutgoingLogicCall
+	iter := sdk.KVStorePrefixIterator(store, outgoingLogicCallKey)
+	for ; iter.Valid(); iter.Next() {
+		var call types.OutgoingLogicCall
+		if err := cdc.UnmarshalBinaryBare(iter.Value(), &call); err != nil {
+			iter.Close()
+			return err
+		}
+		if call.Block == 0 {
+			keys = append(keys, iter.Key())
+			calls = append(calls, &call)
+		}
+	}
+	iter.Close()
+
+	for i, call := range calls {
+		call.Block = uint64(ctx.BlockHeight())
+		bz, err := cdc.MarshalBinaryBare(call)
+		if err != nil {
+			return err
+		}
+		store.Set(keys[i], bz)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/migrations/testutil"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	valAddr1  = sdk.ValAddress("validator1__________")
+	valAddr2  = sdk.ValAddress("validator2__________")
+	orchAddr1 = sdk.AccAddress("orchestrator1_______")
+	ethAddr1  = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	ethAddr2  = "0x7c2C195CD6D34B8F845992d380aADB2730bB9C6F"
+
+	legacyCall  = types.OutgoingLogicCall{InvalidationId: []byte{1}, InvalidationNonce: 1}
+	createdCall = types.OutgoingLogicCall{InvalidationId: []byte{2}, InvalidationNonce: 1, Block: 20}
+)
+
+func logicCallKey(call types.OutgoingLogicCall) string {
+	return string(append(append([]byte{0xde}, call.InvalidationId...), types.UInt64Bytes(call.InvalidationNonce)...))
+}
+
+func logicCall(s testutil.TestStore, call types.OutgoingLogicCall, block uint64) []byte {
+	call.Block = block
+	return s.Marshaler.MustMarshalBinaryBare(&call)
+}
+
+func TestMigrateStore(t *testing.T) {
+	s := testutil.CreateTestStore(t)
+	ctx := s.Context.WithBlockHeight(50)
+	// a store written in the version 1 layout, the reverse indexes and the block
+	// of the logic call created before it was recorded are missing
+	v1Store := map[string][]byte{
+		string(append([]byte{0x1}, valAddr1...)):   []byte(ethAddr1),
+		string(append([]byte{0x1}, valAddr2...)):   []byte(ethAddr2),
+		string(append([]byte{0xe8}, orchAddr1...)): valAddr1,
+		string([]byte{0xf2}):                       types.UInt64Bytes(7),
+		logicCallKey(legacyCall):                   logicCall(s, legacyCall, 0),
+		logicCallKey(createdCall):                  logicCall(s, createdCall, 20),
+	}
+	// v1Store migrated to version 2
+	v2Store := map[string][]byte{
+		string(append([]byte{0x1}, valAddr1...)):   []byte(ethAddr1),
+		string(append([]byte{0x1}, valAddr2...)):   []byte(ethAddr2),
+		string(append([]byte{0xe8}, orchAddr1...)): valAddr1,
+		string(append([]byte{0xe9}, valAddr1...)):  orchAddr1,
+		string(append([]byte{0xea}, ethAddr1...)):  valAddr1,
+		string(append([]byte{0xea}, ethAddr2...)):  valAddr2,
+		string([]byte{0xf2}):                       types.UInt64Bytes(7),
+		logicCallKey(legacyCall):                   logicCall(s, legacyCall, 50),
+		logicCallKey(createdCall):                  logicCall(s, createdCall, 20),
+	}
+	s.Load(v1Store)
+	// the params of version 1, a custom grace period set ahead of the migration is kept
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != string(types.ParamsStoreDelegateKeyRotationGracePeriod) &&
+			string(pair.Key) != string(types.ParamsStoreIBCForwardTimeout) {
+			s.ParamSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	s.ParamSpace.Set(ctx, types.ParamsStoreDelegateKeyRotationGracePeriod, uint64(5))
+
+	// when
+	require.NoError(t, MigrateStore(ctx, s.StoreKey, s.Marshaler, s.ParamSpace, nil))
+
+	// then
+	assert.Equal(t, v2Store, s.Contents())
+	var migrated types.Params
+	s.ParamSpace.GetParamSet(ctx, &migrated)
+	params.DelegateKeyRotationGracePeriod = 5
+	assert.Equal(t, *params, migrated)
+}
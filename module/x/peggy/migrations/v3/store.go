@@ -0,0 +1,123 @@
+package v3
+
+import (
+	"bytes"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// the key layout of version 3, later versions may change it
+var (
+	// feeSecondIndexKey indexes the unbatched txs by token contract bytes and fee
+	feeSecondIndexKey = []byte{0x9}
+
+	// outgoingTXBatchKey indexes the batches by token contract bytes and nonce
+	outgoingTXBatchKey = []byte{0xa}
+
+	// batchConfirmKey indexes the batch confirms by token contract bytes, nonce and orchestrator
+	batchConfirmKey = []byte{0xe1}
+)
+
+// feeAmountLen is the length of the fee amount in the fee index keys
+const feeAmountLen = 32
+
+// MigrateStore migrates the peggy store from version 2 to version 3
+//   - keys batches, batch confirms and the outgoing pool fee index by the 20 bytes of the token
+//     contract instead of its hex string
+//   - merges the fee index entries of token contracts that only differ in casing
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, _ paramtypes.Subspace, _ types.BankKeeper) error {
+	store := ctx.KVStore(storeKey)
+
+	err := rekey(store, outgoingTXBatchKey, func(_, value []byte) ([]byte, error) {
+		var batch types.OutgoingTxBatch
+		if err := cdc.UnmarshalBinaryBare(value, &batch); err != nil {
+			return nil, err
+		}
+		return append(contractBytes(batch.TokenContract), types.UInt64Bytes(batch.BatchNonce)...), nil
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "batches")
+	}
+
+	err = rekey(store, batchConfirmKey, func(key, value []byte) ([]byte, error) {
+		var confirm types.MsgConfirmBatch
+		if err := cdc.UnmarshalBinaryBare(value, &confirm); err != nil {
+			return nil, err
+		}
+		// the old key is the contract string followed by the nonce and the orchestrator
+		contract := []byte(confirm.TokenContract)
+		if !bytes.HasPrefix(key, contract) || len(key) < len(contract)+8 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "key %X does not match contract %s", key, confirm.TokenContract)
+		}
+		return append(contractBytes(confirm.TokenContract), key[len(contract):]...), nil
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "batch confirms")
+	}
+
+	// entries of one contract in different casings end up under the same key and are merged
+	merged := make(map[string]*types.IDSet)
+	err = rekey(store, feeSecondIndexKey, func(key, value []byte) ([]byte, error) {
+		if len(key) < feeAmountLen {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "fee index key %X", key)
+		}
+		var ids types.IDSet
+		if err := cdc.UnmarshalBinaryBare(value, &ids); err != nil {
+			return nil, err
+		}
+		contract, amount := key[:len(key)-feeAmountLen], key[len(key)-feeAmountLen:]
+		newKey := append(contractBytes(string(contract)), amount...)
+		if set, ok := merged[string(newKey)]; ok {
+			set.Ids = append(set.Ids, ids.Ids...)
+		} else {
+			merged[string(newKey)] = &ids
+		}
+		return newKey, nil
+	})
+	if err != nil {
+		return sdkerrors.Wrap(err, "fee index")
+	}
+	feeIndexStore := prefix.NewStore(store, feeSecondIndexKey)
+	for key, ids := range merged {
+		feeIndexStore.Set([]byte(key), cdc.MustMarshalBinaryBare(ids))
+	}
+	return nil
+}
+
+// rekey moves every entry under the prefix to the key returned by newKey, the entries are
+// collected first so that new keys never mix with old keys under iteration
+func rekey(store sdk.KVStore, keyPrefix []byte, newKey func(key, value []byte) ([]byte, error)) error {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	var oldKeys, newKeys, values [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		key, err := newKey(iter.Key(), iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		oldKeys = append(oldKeys, iter.Key())
+		newKeys = append(newKeys, key)
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	for _, key := range oldKeys {
+		prefixStore.Delete(key)
+	}
+	for i, key := range newKeys {
+		prefixStore.Set(key, values[i])
+	}
+	return nil
+}
+
+// contractBytes returns the 20 bytes of a token contract address in any casing
+func contractBytes(contract string) []byte {
+	return gethcommon.HexToAddress(contract).Bytes()
+}
@@ -0,0 +1,71 @@
+package v3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/migrations/testutil"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	orchAddr      = sdk.AccAddress("orchestrator1_______")
+)
+
+// feeAmount is the 32 byte fee amount of the fee index keys
+func feeAmount(amount int64) []byte {
+	return sdk.NewInt(amount).BigInt().FillBytes(make([]byte, feeAmountLen))
+}
+
+func join(parts ...[]byte) string {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return string(out)
+}
+
+func TestMigrateStore(t *testing.T) {
+	s := testutil.CreateTestStore(t)
+	cdc := s.Marshaler
+	var (
+		lower    = strings.ToLower(tokenContract)
+		contract = gethcommon.HexToAddress(tokenContract).Bytes()
+		batch    = cdc.MustMarshalBinaryBare(&types.OutgoingTxBatch{BatchNonce: 4, TokenContract: tokenContract})
+		confirm  = cdc.MustMarshalBinaryBare(&types.MsgConfirmBatch{Nonce: 4, TokenContract: tokenContract, Orchestrator: orchAddr.String()})
+		ids      = func(ids ...uint64) []byte { return cdc.MustMarshalBinaryBare(&types.IDSet{Ids: ids}) }
+	)
+	// the version 2 layout keys by the contract string, the pool holds txs of the contract in two casings
+	v2Store := map[string][]byte{
+		join([]byte{0x9}, []byte(tokenContract), feeAmount(2)):                    ids(1, 2),
+		join([]byte{0x9}, []byte(lower), feeAmount(2)):                            ids(3),
+		join([]byte{0x9}, []byte(lower), feeAmount(5)):                            ids(4),
+		join([]byte{0xa}, []byte(tokenContract), types.UInt64Bytes(4)):            batch,
+		join([]byte{0xe1}, []byte(tokenContract), types.UInt64Bytes(4), orchAddr): confirm,
+		join([]byte{0xf2}): types.UInt64Bytes(7),
+	}
+	s.Load(v2Store)
+
+	// when
+	require.NoError(t, MigrateStore(s.Context, s.StoreKey, cdc, s.ParamSpace, nil))
+
+	// then
+	v3Store := map[string][]byte{
+		join([]byte{0x9}, contract, feeAmount(2)):                    ids(1, 2, 3),
+		join([]byte{0x9}, contract, feeAmount(5)):                    ids(4),
+		join([]byte{0xa}, contract, types.UInt64Bytes(4)):            batch,
+		join([]byte{0xe1}, contract, types.UInt64Bytes(4), orchAddr): confirm,
+		join([]byte{0xf2}): types.UInt64Bytes(7),
+	}
+	assert.Equal(t, v3Store, s.Contents())
+
+	// the keys match the ones the keeper uses
+	assert.Equal(t, join(types.GetFeeSecondIndexKey(lower, sdk.NewInt64Coin("stake", 5))), join([]byte{0x9}, contract, feeAmount(5)))
+	assert.Equal(t, join(types.GetOutgoingTxBatchKey(lower, 4)), join([]byte{0xa}, contract, types.UInt64Bytes(4)))
+	assert.Equal(t, join(types.GetBatchConfirmKey(lower, 4, orchAddr)), join([]byte{0xe1}, contract, types.UInt64Bytes(4), orchAddr))
+}
@@ -0,0 +1,321 @@
+package v4
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+)
+
+// the key layout of version 4, later versions may change it
+var (
+	ethAddressKey                 = []byte{0x1}
+	valsetRequestKey              = []byte{0x2}
+	valsetConfirmKey              = []byte{0x3}
+	oracleAttestationKey          = []byte{0x5}
+	outgoingTXPoolKey             = []byte{0x6}
+	outgoingTXBatchKey            = []byte{0xa}
+	batchConfirmKey               = []byte{0xe1}
+	outgoingLogicCallKey          = []byte{0xde}
+	outgoingLogicConfirmKey       = []byte{0xae}
+	denomToERC20Key               = []byte{0xf3}
+	erc20ToDenomKey               = []byte{0xf4}
+	ethAddressValidatorKey        = []byte{0xea}
+	pendingDelegateKeyRotationKey = []byte{0xec}
+	previousDelegateKeysKey       = []byte{0xed}
+)
+
+// MigrateStore migrates the peggy store from version 3 to version 4, every stored ethereum
+// address is replaced by its EIP-55 checksummed form
+//   - rewrites the addresses of delegate keys, valsets, confirms, the outgoing pool, batches,
+//     logic calls and the Cosmos originated ERC20 mappings, of the index entries of one address
+//     in different casings the first one is kept and the reverse mappings of the others are
+//     deleted, their validators have to set an ethereum address again
+//   - keys attestations by the hash of their normalized claim, the votes of attestations that
+//     only differed in casing are merged
+//   - swaps vouchers with a non checksummed denom for vouchers of the checksummed denom, this
+//     includes vouchers escrowed by ICS-20 channels, the IBC middleware resolves the old denoms
+//     of packets that return them
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, _ paramtypes.Subspace, bankKeeper types.BankKeeper) error {
+	store := ctx.KVStore(storeKey)
+	normalize := types.NormalizeEthAddress
+
+	normalizeValue := func(bz []byte) ([]byte, error) {
+		return []byte(normalize(string(bz))), nil
+	}
+	for _, keyPrefix := range [][]byte{ethAddressKey, denomToERC20Key} {
+		if err := updateValues(store, keyPrefix, normalizeValue); err != nil {
+			return err
+		}
+	}
+	rekeyAddresses(store, ethAddressValidatorKey, ethAddressKey)
+	rekeyAddresses(store, erc20ToDenomKey, denomToERC20Key)
+
+	var (
+		valset        types.Valset
+		valsetConfirm types.MsgValsetConfirm
+		batchConfirm  types.MsgConfirmBatch
+		logicConfirm  types.MsgConfirmLogicCall
+		tx            types.OutgoingTx
+		batch         types.OutgoingTxBatch
+		call          types.OutgoingLogicCall
+		rotation      types.DelegateKeyRotation
+	)
+	normalizeTokens := func(tokens ...*types.ERC20Token) {
+		for _, token := range tokens {
+			if token != nil {
+				token.Contract = normalize(token.Contract)
+			}
+		}
+	}
+	for _, p := range []struct {
+		keyPrefix []byte
+		msg       codec.ProtoMarshaler
+		normalize func()
+	}{
+		{valsetRequestKey, &valset, func() {
+			// members keep their order, it is part of the signed checkpoint
+			for _, m := range valset.Members {
+				m.EthereumAddress = normalize(m.EthereumAddress)
+			}
+		}},
+		{valsetConfirmKey, &valsetConfirm, func() {
+			valsetConfirm.EthAddress = normalize(valsetConfirm.EthAddress)
+		}},
+		{batchConfirmKey, &batchConfirm, func() {
+			batchConfirm.EthSigner = normalize(batchConfirm.EthSigner)
+			batchConfirm.TokenContract = normalize(batchConfirm.TokenContract)
+		}},
+		{outgoingLogicConfirmKey, &logicConfirm, func() {
+			logicConfirm.EthSigner = normalize(logicConfirm.EthSigner)
+		}},
+		{outgoingTXPoolKey, &tx, func() {
+			tx.DestAddr = normalize(tx.DestAddr)
+			tx.Amount.Denom = voucherDenom(tx.Amount.Denom)
+			tx.BridgeFee.Denom = voucherDenom(tx.BridgeFee.Denom)
+		}},
+		{outgoingTXBatchKey, &batch, func() {
+			batch.TokenContract = normalize(batch.TokenContract)
+			for _, tx := range batch.Transactions {
+				tx.DestAddress = normalize(tx.DestAddress)
+				normalizeTokens(tx.Erc20Token, tx.Erc20Fee)
+			}
+		}},
+		{outgoingLogicCallKey, &call, func() {
+			call.LogicContractAddress = normalize(call.LogicContractAddress)
+			normalizeTokens(call.Transfers...)
+			normalizeTokens(call.Fees...)
+		}},
+		{pendingDelegateKeyRotationKey, &rotation, func() {
+			rotation.EthAddress = normalize(rotation.EthAddress)
+		}},
+		{previousDelegateKeysKey, &rotation, func() {
+			rotation.EthAddress = normalize(rotation.EthAddress)
+		}},
+	} {
+		err := updateValues(store, p.keyPrefix, func(bz []byte) ([]byte, error) {
+			p.msg.Reset()
+			if err := cdc.UnmarshalBinaryBare(bz, p.msg); err != nil {
+				return nil, err
+			}
+			p.normalize()
+			return cdc.MarshalBinaryBare(p.msg)
+		})
+		if err != nil {
+			return sdkerrors.Wrapf(err, "prefix %X", p.keyPrefix)
+		}
+	}
+
+	if err := migrateAttestations(store, cdc); err != nil {
+		return sdkerrors.Wrap(err, "attestations")
+	}
+	return migrateVouchers(ctx, bankKeeper)
+}
+
+// updateValues replaces every value under the prefix by the result of update
+func updateValues(store sdk.KVStore, keyPrefix []byte, update func(value []byte) ([]byte, error)) error {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	var keys, values [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		value, err := update(iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		keys = append(keys, iter.Key())
+		values = append(values, value)
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		prefixStore.Set(key, values[i])
+	}
+	return nil
+}
+
+// rekeyAddresses replaces the address keys under the prefix by their normalized form. When
+// several casings of one address are present the first entry wins, the reverse mappings under
+// reversePrefix of the other entries are deleted if they still point to the address, so that
+// no two values are mapped to one address.
+func rekeyAddresses(store sdk.KVStore, keyPrefix, reversePrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	reverseStore := prefix.NewStore(store, reversePrefix)
+	var keys, values [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	winners := make(map[string][]byte)
+	for i, key := range keys {
+		normalized := types.NormalizeEthAddress(string(key))
+		winner, ok := winners[normalized]
+		if !ok {
+			winners[normalized] = values[i]
+			prefixStore.Set([]byte(normalized), values[i])
+			continue
+		}
+		if !bytes.Equal(winner, values[i]) && string(reverseStore.Get(values[i])) == normalized {
+			reverseStore.Delete(values[i])
+		}
+	}
+}
+
+// migrateAttestations keys the attestations by the hash of their normalized claims
+func migrateAttestations(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	prefixStore := prefix.NewStore(store, oracleAttestationKey)
+	var oldKeys, newKeys []string
+	attestations := make(map[string]*types.Attestation)
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		att, claim, err := normalizeAttestation(cdc, iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+
+		oldKeys = append(oldKeys, string(iter.Key()))
+		key := string(append(types.UInt64Bytes(claim.GetEventNonce()), claim.ClaimHash()...))
+		if merged, ok := attestations[key]; ok {
+			mergeAttestation(merged, att)
+		} else {
+			attestations[key] = att
+			newKeys = append(newKeys, key)
+		}
+	}
+	iter.Close()
+
+	for _, key := range oldKeys {
+		prefixStore.Delete([]byte(key))
+	}
+	for _, key := range newKeys {
+		prefixStore.Set([]byte(key), cdc.MustMarshalBinaryBare(attestations[key]))
+	}
+	return nil
+}
+
+// normalizeAttestation decodes an attestation and normalizes the addresses of its claim
+func normalizeAttestation(cdc codec.BinaryMarshaler, bz []byte) (*types.Attestation, types.EthereumClaim, error) {
+	var att types.Attestation
+	if err := cdc.UnmarshalBinaryBare(bz, &att); err != nil {
+		return nil, nil, err
+	}
+	var claim types.EthereumClaim
+	if err := cdc.UnpackAny(att.Claim, &claim); err != nil {
+		return nil, nil, err
+	}
+	types.NormalizeClaim(claim)
+	msg, ok := claim.(proto.Message)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalid, "claim %T is not a proto message", claim)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	att.Claim = any
+	return &att, claim, nil
+}
+
+// mergeAttestation adds the votes of other to att, att is observed if either of them is
+func mergeAttestation(att, other *types.Attestation) {
+	for _, vote := range other.Votes {
+		if !containsKey(att.Votes, vote) {
+			att.Votes = append(att.Votes, vote)
+		}
+	}
+	att.Observed = att.Observed || other.Observed
+	if other.Height < att.Height {
+		att.Height = other.Height
+	}
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// migrateVouchers swaps vouchers with a non checksummed denom for vouchers of the checksummed
+// denom by burning and minting them through the module account
+func migrateVouchers(ctx sdk.Context, bankKeeper types.BankKeeper) error {
+	type holding struct {
+		addr sdk.AccAddress
+		coin sdk.Coin
+	}
+	var holdings []holding
+	bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if coin.IsPositive() && voucherDenom(coin.Denom) != coin.Denom {
+			holdings = append(holdings, holding{addr: addr, coin: coin})
+		}
+		return false
+	})
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, h := range holdings {
+		old := sdk.NewCoins(h.coin)
+		swapped := sdk.NewCoins(sdk.NewCoin(voucherDenom(h.coin.Denom), h.coin.Amount))
+		if err := bankKeeper.SendCoins(ctx, h.addr, moduleAddr, old); err != nil {
+			return sdkerrors.Wrapf(err, "take %s from %s", old, h.addr)
+		}
+		if err := bankKeeper.BurnCoins(ctx, types.ModuleName, old); err != nil {
+			return sdkerrors.Wrapf(err, "burn %s", old)
+		}
+		if err := bankKeeper.MintCoins(ctx, types.ModuleName, swapped); err != nil {
+			return sdkerrors.Wrapf(err, "mint %s", swapped)
+		}
+		if err := bankKeeper.SendCoins(ctx, moduleAddr, h.addr, swapped); err != nil {
+			return sdkerrors.Wrapf(err, "return %s to %s", swapped, h.addr)
+		}
+	}
+	return nil
+}
+
+// voucherDenom returns the checksummed denom of a voucher denom in any casing, other denoms
+// are returned unchanged
+func voucherDenom(denom string) string {
+	contract := strings.TrimPrefix(denom, types.PeggyDenomPrefix+types.PeggyDenomSeparator)
+	if len(denom) != types.PeggyDenomLen || contract == denom ||
+		!strings.HasPrefix(contract, "0x") || !gethcommon.IsHexAddress(contract) {
+		return denom
+	}
+	return types.PeggyDenom(contract)
+}
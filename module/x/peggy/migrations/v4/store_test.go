@@ -0,0 +1,157 @@
+package v4
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/migrations/testutil"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	valAddr       = sdk.ValAddress("validator1__________")
+	orchAddr1     = sdk.AccAddress("orchestrator1_______")
+	orchAddr2     = sdk.AccAddress("orchestrator2_______")
+	ethAddr       = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+)
+
+// noBalances is a bank keeper without any balances
+type noBalances struct {
+	types.BankKeeper
+}
+
+func (noBalances) IterateAllBalances(sdk.Context, func(sdk.AccAddress, sdk.Coin) bool) {}
+
+func join(parts ...[]byte) string {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return string(out)
+}
+
+func TestMigrateStore(t *testing.T) {
+	s := testutil.CreateTestStore(t)
+	cdc := s.Marshaler
+	var (
+		lowerEth   = strings.ToLower(ethAddr)
+		lowerToken = strings.ToLower(tokenContract)
+		confirm    = func(ethAddr string) []byte {
+			return cdc.MustMarshalBinaryBare(&types.MsgValsetConfirm{Nonce: 2, Orchestrator: orchAddr1.String(), EthAddress: ethAddr})
+		}
+		claim = func(tokenContract string) *types.MsgDepositClaim {
+			return &types.MsgDepositClaim{EventNonce: 3, TokenContract: tokenContract, Amount: sdk.NewInt(10), EthereumSender: ethAddr, CosmosReceiver: orchAddr1.String()}
+		}
+		attestation = func(claim *types.MsgDepositClaim, height uint64, votes ...string) []byte {
+			any, err := codectypes.NewAnyWithValue(claim)
+			require.NoError(t, err)
+			return cdc.MustMarshalBinaryBare(&types.Attestation{Height: height, Votes: votes, Claim: any})
+		}
+		attestationKey = func(claim *types.MsgDepositClaim) []byte {
+			return append(types.UInt64Bytes(claim.EventNonce), claim.ClaimHash()...)
+		}
+	)
+	// the version 3 layout keeps addresses in the casing they were submitted with, the
+	// attestations of one deposit are split by the casing of the token contract
+	v3Store := map[string][]byte{
+		join([]byte{0x1}, valAddr):                              []byte(lowerEth),
+		join([]byte{0x3}, types.UInt64Bytes(2), orchAddr1):      confirm(lowerEth),
+		join([]byte{0x5}, attestationKey(claim(tokenContract))): attestation(claim(tokenContract), 8, "val1"),
+		join([]byte{0x5}, attestationKey(claim(lowerToken))):    attestation(claim(lowerToken), 7, "val2", "val1"),
+		join([]byte{0xea}, []byte(ethAddr)):                     valAddr,
+		join([]byte{0xea}, []byte(lowerEth)):                    orchAddr2,
+		join([]byte{0xf3}, []byte("ucosmos")):                   []byte(lowerToken),
+		join([]byte{0xf4}, []byte(lowerToken)):                  []byte("ucosmos"),
+	}
+	s.Load(v3Store)
+
+	// when
+	require.NoError(t, MigrateStore(s.Context, s.StoreKey, cdc, s.ParamSpace, noBalances{}))
+
+	// then
+	v4Store := map[string][]byte{
+		join([]byte{0x1}, valAddr):                              []byte(ethAddr),
+		join([]byte{0x3}, types.UInt64Bytes(2), orchAddr1):      confirm(ethAddr),
+		join([]byte{0x5}, attestationKey(claim(tokenContract))): attestation(claim(tokenContract), 7, "val1", "val2"),
+		join([]byte{0xea}, []byte(ethAddr)):                     valAddr,
+		join([]byte{0xf3}, []byte("ucosmos")):                   []byte(tokenContract),
+		join([]byte{0xf4}, []byte(tokenContract)):               []byte("ucosmos"),
+	}
+	assert.Equal(t, v4Store, s.Contents())
+}
+
+func TestMigrateStoreAddressCollisions(t *testing.T) {
+	var (
+		lowerEth   = strings.ToLower(ethAddr)
+		lowerToken = strings.ToLower(tokenContract)
+		valAddr2   = sdk.ValAddress("validator2__________")
+		valAddr3   = sdk.ValAddress("validator3__________")
+	)
+	specs := map[string]struct {
+		v3Store map[string][]byte
+		v4Store map[string][]byte
+	}{
+		"two validators share an eth address": {
+			v3Store: map[string][]byte{
+				join([]byte{0x1}, valAddr):           []byte(ethAddr),
+				join([]byte{0x1}, valAddr2):          []byte(lowerEth),
+				join([]byte{0xea}, []byte(ethAddr)):  valAddr,
+				join([]byte{0xea}, []byte(lowerEth)): valAddr2,
+			},
+			v4Store: map[string][]byte{
+				join([]byte{0x1}, valAddr):          []byte(ethAddr),
+				join([]byte{0xea}, []byte(ethAddr)): valAddr,
+			},
+		},
+		"a validator that moved on keeps its eth address": {
+			v3Store: map[string][]byte{
+				join([]byte{0x1}, valAddr):           []byte(ethAddr),
+				join([]byte{0x1}, valAddr3):          []byte(tokenContract),
+				join([]byte{0xea}, []byte(ethAddr)):  valAddr,
+				join([]byte{0xea}, []byte(lowerEth)): valAddr3,
+			},
+			v4Store: map[string][]byte{
+				join([]byte{0x1}, valAddr):          []byte(ethAddr),
+				join([]byte{0x1}, valAddr3):         []byte(tokenContract),
+				join([]byte{0xea}, []byte(ethAddr)): valAddr,
+			},
+		},
+		"two denoms share an erc20 contract": {
+			v3Store: map[string][]byte{
+				join([]byte{0xf3}, []byte("ucosmos")):     []byte(tokenContract),
+				join([]byte{0xf3}, []byte("uatom")):       []byte(lowerToken),
+				join([]byte{0xf4}, []byte(tokenContract)): []byte("ucosmos"),
+				join([]byte{0xf4}, []byte(lowerToken)):    []byte("uatom"),
+			},
+			v4Store: map[string][]byte{
+				join([]byte{0xf3}, []byte("ucosmos")):     []byte(tokenContract),
+				join([]byte{0xf4}, []byte(tokenContract)): []byte("ucosmos"),
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			s := testutil.CreateTestStore(t)
+			s.Load(spec.v3Store)
+
+			// when
+			require.NoError(t, MigrateStore(s.Context, s.StoreKey, s.Marshaler, s.ParamSpace, noBalances{}))
+
+			// then
+			assert.Equal(t, spec.v4Store, s.Contents())
+		})
+	}
+}
+
+func TestVoucherDenom(t *testing.T) {
+	denom := types.PeggyDenom(tokenContract)
+	assert.Equal(t, denom, voucherDenom(strings.ToLower(denom)))
+	assert.Equal(t, denom, voucherDenom(denom))
+	assert.Equal(t, "stake", voucherDenom("stake"))
+	assert.Equal(t, "peggy0xinvalid", voucherDenom("peggy0xinvalid"))
+}
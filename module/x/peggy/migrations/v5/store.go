@@ -0,0 +1,18 @@
+package v5
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore migrates the peggy store from version 4 to version 5
+//   - sets the transfer record retention param added in version 5 to its default, records are
+//     only written for transfers executed after the migration
+func MigrateStore(ctx sdk.Context, _ sdk.StoreKey, _ codec.BinaryMarshaler, paramSpace paramtypes.Subspace, _ types.BankKeeper) error {
+	if !paramSpace.Has(ctx, types.ParamsStoreTransferRecordRetention) {
+		paramSpace.Set(ctx, types.ParamsStoreTransferRecordRetention, types.DefaultParams().TransferRecordRetention)
+	}
+	return nil
+}
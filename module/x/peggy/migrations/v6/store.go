@@ -0,0 +1,18 @@
+package v6
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore migrates the peggy store from version 5 to version 6
+//   - sets the deposit receipt retention param added in version 6 to its default, receipts are
+//     only written for deposits credited after the migration
+func MigrateStore(ctx sdk.Context, _ sdk.StoreKey, _ codec.BinaryMarshaler, paramSpace paramtypes.Subspace, _ types.BankKeeper) error {
+	if !paramSpace.Has(ctx, types.ParamsStoreDepositReceiptRetention) {
+		paramSpace.Set(ctx, types.ParamsStoreDepositReceiptRetention, types.DefaultParams().DepositReceiptRetention)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/migrations/testutil"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	s := testutil.CreateTestStore(t)
+	ctx := s.Context
+	// the params of version 5
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != string(types.ParamsStoreDepositReceiptRetention) {
+			s.ParamSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	// when
+	require.NoError(t, MigrateStore(ctx, s.StoreKey, s.Marshaler, s.ParamSpace, nil))
+
+	// then
+	var migrated types.Params
+	s.ParamSpace.GetParamSet(ctx, &migrated)
+	assert.Equal(t, *params, migrated)
+
+	// a retention set ahead of the migration is kept
+	s.ParamSpace.Set(ctx, types.ParamsStoreDepositReceiptRetention, uint64(5))
+	require.NoError(t, MigrateStore(ctx, s.StoreKey, s.Marshaler, s.ParamSpace, nil))
+	s.ParamSpace.GetParamSet(ctx, &migrated)
+	assert.Equal(t, uint64(5), migrated.DepositReceiptRetention)
+}
@@ -112,8 +112,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants implements app module
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO: make some invariants in the peggy module to ensure that
-	// coins aren't being fraudlently minted etc...
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route implements app module
@@ -151,6 +150,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(&gs)
 }
 
+// ConsensusVersion returns the consensus version of the module store
+func (AppModule) ConsensusVersion() uint64 {
+	return types.ConsensusVersion
+}
+
 // BeginBlock implements app module
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
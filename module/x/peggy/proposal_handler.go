@@ -0,0 +1,77 @@
+package peggy
+
+import (
+	"github.com/althea-net/peggy/module/x/peggy/keeper"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler returns a handler for "Peggy" type proposals.
+func NewProposalHandler(k keeper.Keeper, dk types.DistributionKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.LogicCallProposal:
+			return handleLogicCallProposal(ctx, k, dk, c)
+
+		case *types.ERC20DeploymentProposal:
+			return k.ApproveERC20Deployment(ctx, &types.ERC20DeploymentRequest{
+				Denom:    c.Denom,
+				Name:     c.Name,
+				Symbol:   c.Symbol,
+				Decimals: c.Decimals,
+			})
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized peggy proposal content type: %T", c)
+		}
+	}
+}
+
+// handleLogicCallProposal takes the transfers and fees of the call from the community pool
+// and creates the logic call on behalf of the distribution module
+func handleLogicCallProposal(ctx sdk.Context, k keeper.Keeper, dk types.DistributionKeeper, p *types.LogicCallProposal) error {
+	feePool := dk.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(p.Transfers.Add(p.Fees...)...))
+	if negative {
+		return distrtypes.ErrBadDistribution
+	}
+	feePool.CommunityPool = newPool
+	dk.SetFeePool(ctx, feePool)
+
+	_, err := k.CreateLogicCall(ctx, distrtypes.ModuleName, p.Transfers, p.Fees, p.LogicContractAddress, p.Payload, p.Timeout)
+	return err
+}
+
+// CommunityPoolLogicCallHooks puts the refunds of canceled logic call proposals back into the community pool
+type CommunityPoolLogicCallHooks struct {
+	k  keeper.Keeper
+	dk types.DistributionKeeper
+}
+
+var _ types.LogicCallHooks = CommunityPoolLogicCallHooks{}
+
+// NewCommunityPoolLogicCallHooks returns the hooks to register for the logic calls of the distribution module
+func NewCommunityPoolLogicCallHooks(k keeper.Keeper, dk types.DistributionKeeper) CommunityPoolLogicCallHooks {
+	return CommunityPoolLogicCallHooks{k: k, dk: dk}
+}
+
+// AfterLogicCallExecuted has nothing to do, the funds left the community pool when the proposal passed
+func (h CommunityPoolLogicCallHooks) AfterLogicCallExecuted(ctx sdk.Context, call types.OutgoingLogicCall) {
+}
+
+// AfterLogicCallCanceled credits the refund the distribution module account received to the community pool
+func (h CommunityPoolLogicCallHooks) AfterLogicCallCanceled(ctx sdk.Context, call types.OutgoingLogicCall) {
+	var refund sdk.Coins
+	for _, tokens := range [][]*types.ERC20Token{call.Transfers, call.Fees} {
+		for _, token := range tokens {
+			_, denom := h.k.ERC20ToDenom(ctx, token.Contract)
+			refund = refund.Add(sdk.NewCoin(denom, token.Amount))
+		}
+	}
+	feePool := h.dk.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(refund...)...)
+	h.dk.SetFeePool(ctx, feePool)
+}
@@ -0,0 +1,62 @@
+package peggy
+
+import (
+	"testing"
+
+	"github.com/althea-net/peggy/module/x/peggy/keeper"
+	"github.com/althea-net/peggy/module/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogicCallProposal(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.PeggyKeeper
+	var (
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		logicContractAddr   = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		distrAddr           = authtypes.NewModuleAddress(distrtypes.ModuleName)
+	)
+	k.SetLogicCallHooks(distrtypes.ModuleName, NewCommunityPoolLogicCallHooks(k, input.DistKeeper))
+	h := NewProposalHandler(k, input.DistKeeper)
+
+	// bridged funds in the community pool
+	vouchers := sdk.NewCoins(types.NewERC20Token(1000, myTokenContractAddr).PeggyCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, vouchers))
+	feePool := input.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(vouchers...)
+	input.DistKeeper.SetFeePool(ctx, feePool)
+
+	transfers := sdk.NewCoins(types.NewERC20Token(600, myTokenContractAddr).PeggyCoin())
+	fees := sdk.NewCoins(types.NewERC20Token(10, myTokenContractAddr).PeggyCoin())
+	p := types.NewLogicCallProposal("grant", "pay a grant", logicContractAddr, []byte("payload"), transfers, fees, 500)
+	require.NoError(t, p.ValidateBasic())
+
+	// when
+	require.NoError(t, h(ctx, p))
+
+	// then
+	calls := k.GetOutgoingLogicCalls(ctx)
+	require.Len(t, calls, 1)
+	assert.Equal(t, distrtypes.ModuleName, calls[0].SourceModule)
+	assert.Equal(t, []*types.ERC20Token{types.NewERC20Token(600, myTokenContractAddr)}, calls[0].Transfers)
+	assert.Equal(t, []*types.ERC20Token{types.NewERC20Token(10, myTokenContractAddr)}, calls[0].Fees)
+	assert.Equal(t, []byte("payload"), calls[0].Payload)
+	left := sdk.NewCoins(types.NewERC20Token(390, myTokenContractAddr).PeggyCoin())
+	assert.Equal(t, sdk.NewDecCoinsFromCoins(left...), input.DistKeeper.GetFeePool(ctx).CommunityPool)
+	assert.Equal(t, left.AmountOf(left[0].Denom), input.BankKeeper.GetBalance(ctx, distrAddr, left[0].Denom).Amount)
+
+	// the community pool can not be overdrawn
+	assert.Error(t, h(ctx, p))
+	assert.Len(t, k.GetOutgoingLogicCalls(ctx), 1)
+
+	// and gets the funds of a canceled call back
+	require.NoError(t, k.CancelOutgoingLogicCall(ctx, calls[0].InvalidationId, calls[0].InvalidationNonce))
+	assert.Equal(t, sdk.NewDecCoinsFromCoins(vouchers...), input.DistKeeper.GetFeePool(ctx).CommunityPool)
+	assert.Equal(t, vouchers.AmountOf(vouchers[0].Denom), input.BankKeeper.GetBalance(ctx, distrAddr, vouchers[0].Denom).Amount)
+}
@@ -144,6 +144,104 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+// ERC20MetadataAttestation collects the votes of validators for the metadata of an
+// Ethereum originated ERC20. The metadata is read from the ERC20 contract and not
+// from an event of the peggy contract, so it has no event nonce and is attested by
+// token contract instead. A validator has one vote per token contract, the metadata
+// is applied once the votes pass the attestation threshold and replaces the other
+// attestations of the token contract
+type ERC20MetadataAttestation struct {
+	TokenContract string   `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Name          string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string   `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals      uint64   `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Observed      bool     `protobuf:"varint,5,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes         []string `protobuf:"bytes,6,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height        uint64   `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ERC20MetadataAttestation) Reset()         { *m = ERC20MetadataAttestation{} }
+func (m *ERC20MetadataAttestation) String() string { return proto.CompactTextString(m) }
+func (*ERC20MetadataAttestation) ProtoMessage()    {}
+func (*ERC20MetadataAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20f100b984cd48a5, []int{1}
+}
+func (m *ERC20MetadataAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20MetadataAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20MetadataAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20MetadataAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20MetadataAttestation.Merge(m, src)
+}
+func (m *ERC20MetadataAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20MetadataAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20MetadataAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20MetadataAttestation proto.InternalMessageInfo
+
+func (m *ERC20MetadataAttestation) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *ERC20MetadataAttestation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ERC20MetadataAttestation) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ERC20MetadataAttestation) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *ERC20MetadataAttestation) GetObserved() bool {
+	if m != nil {
+		return m.Observed
+	}
+	return false
+}
+
+func (m *ERC20MetadataAttestation) GetVotes() []string {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *ERC20MetadataAttestation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token (note: developers should look up
@@ -157,7 +255,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20f100b984cd48a5, []int{1}
+	return fileDescriptor_20f100b984cd48a5, []int{2}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,46 +291,157 @@ func (m *ERC20Token) GetContract() string {
 	return ""
 }
 
+// DepositReceipt records a deposit from Ethereum credited on Cosmos. cosmos_receiver is the
+// account credited, deposits forwarded over IBC credit the local account of the Ethereum sender.
+// height is the Cosmos block height the deposit was credited at, receipts are pruned
+// deposit_receipt_retention blocks later
+type DepositReceipt struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeight uint64                                 `protobuf:"varint,2,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	EthereumSender string                                 `protobuf:"bytes,3,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,4,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	TokenContract  string                                 `protobuf:"bytes,5,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Denom          string                                 `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Height         uint64                                 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DepositReceipt) Reset()         { *m = DepositReceipt{} }
+func (m *DepositReceipt) String() string { return proto.CompactTextString(m) }
+func (*DepositReceipt) ProtoMessage()    {}
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20f100b984cd48a5, []int{3}
+}
+func (m *DepositReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReceipt.Merge(m, src)
+}
+func (m *DepositReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositReceipt proto.InternalMessageInfo
+
+func (m *DepositReceipt) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("peggy.v1.ClaimType", ClaimType_name, ClaimType_value)
 	proto.RegisterType((*Attestation)(nil), "peggy.v1.Attestation")
+	proto.RegisterType((*ERC20MetadataAttestation)(nil), "peggy.v1.ERC20MetadataAttestation")
 	proto.RegisterType((*ERC20Token)(nil), "peggy.v1.ERC20Token")
+	proto.RegisterType((*DepositReceipt)(nil), "peggy.v1.DepositReceipt")
 }
 
 func init() { proto.RegisterFile("peggy/v1/attestation.proto", fileDescriptor_20f100b984cd48a5) }
 
 var fileDescriptor_20f100b984cd48a5 = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x49, 0x1a, 0x25, 0xd7, 0x25, 0x3a, 0xa2, 0xe2, 0x9e, 0x84, 0x6b, 0x65, 0x40,
-	0x51, 0xa5, 0xde, 0xa5, 0x65, 0x65, 0x71, 0x1d, 0x17, 0x0c, 0x26, 0xa9, 0x8c, 0xab, 0x50, 0x96,
-	0xc8, 0x71, 0x0e, 0x27, 0x6a, 0xec, 0xb3, 0xe2, 0x4b, 0x84, 0x67, 0x16, 0x94, 0x89, 0x2f, 0x90,
-	0x89, 0x89, 0x6f, 0xd2, 0xb1, 0x23, 0x62, 0xa8, 0x50, 0xf2, 0x45, 0x50, 0x6c, 0x53, 0x22, 0x28,
-	0xea, 0x64, 0xff, 0xdf, 0xef, 0x7f, 0xef, 0xfe, 0x7a, 0xef, 0x20, 0x8e, 0x98, 0xef, 0x27, 0x74,
-	0x7e, 0x4c, 0x5d, 0x21, 0x58, 0x2c, 0x5c, 0x31, 0xe6, 0x21, 0x89, 0xa6, 0x5c, 0x70, 0x54, 0x49,
-	0x19, 0x99, 0x1f, 0xe3, 0xba, 0xcf, 0x7d, 0x9e, 0x16, 0xe9, 0xe6, 0x2f, 0xe3, 0x78, 0xdf, 0xe7,
-	0xdc, 0x9f, 0x30, 0x9a, 0xaa, 0xc1, 0xec, 0x03, 0x75, 0xc3, 0x24, 0x43, 0x8d, 0x4f, 0x00, 0xee,
-	0x6a, 0x7f, 0x1a, 0x22, 0x0c, 0x2b, 0x7c, 0x10, 0xb3, 0xe9, 0x9c, 0x0d, 0x65, 0xa0, 0x82, 0x66,
-	0xc5, 0xbe, 0xd3, 0xa8, 0x0e, 0x77, 0xe6, 0x5c, 0xb0, 0x58, 0x2e, 0xa8, 0xc5, 0x66, 0xd5, 0xce,
-	0x04, 0xda, 0x83, 0xe5, 0x11, 0x1b, 0xfb, 0x23, 0x21, 0x17, 0x55, 0xd0, 0x2c, 0xd9, 0xb9, 0x42,
-	0x87, 0x70, 0xc7, 0x9b, 0xb8, 0xe3, 0x40, 0x2e, 0xa9, 0xa0, 0xb9, 0x7b, 0x52, 0x27, 0x59, 0x08,
-	0xf2, 0x3b, 0x04, 0xd1, 0xc2, 0xc4, 0xce, 0x2c, 0x8d, 0x08, 0x42, 0xc3, 0xd6, 0x4f, 0x5a, 0x0e,
-	0xbf, 0x62, 0x69, 0x06, 0x8f, 0x87, 0x62, 0xea, 0x7a, 0x22, 0xcd, 0x50, 0xb5, 0xef, 0x34, 0x3a,
-	0x83, 0x65, 0x37, 0xe0, 0xb3, 0x50, 0xc8, 0x85, 0x0d, 0x39, 0x25, 0xd7, 0xb7, 0x07, 0xd2, 0x8f,
-	0xdb, 0x83, 0xa7, 0xfe, 0x58, 0x8c, 0x66, 0x03, 0xe2, 0xf1, 0x80, 0x7a, 0x3c, 0x0e, 0x78, 0x9c,
-	0x7f, 0x8e, 0xe2, 0xe1, 0x15, 0x15, 0x49, 0xc4, 0x62, 0x62, 0x86, 0xc2, 0xce, 0x4f, 0x1f, 0x7e,
-	0x2b, 0xc0, 0xaa, 0xbe, 0xb9, 0xdb, 0x49, 0x22, 0x86, 0x08, 0x44, 0xba, 0xa5, 0x99, 0x6f, 0xfa,
-	0xce, 0xe5, 0xb9, 0xd1, 0xbf, 0xe8, 0xbc, 0xee, 0x74, 0x7b, 0x9d, 0x9a, 0x84, 0xf7, 0x16, 0x4b,
-	0xf5, 0x1e, 0xf2, 0x97, 0xbf, 0x6d, 0x9c, 0x77, 0xdf, 0x9a, 0x4e, 0x0d, 0xfc, 0xe3, 0xcf, 0x09,
-	0x6a, 0xc1, 0x47, 0x5b, 0xd5, 0x9e, 0xe9, 0xbc, 0x6c, 0xdb, 0x5a, 0xaf, 0x56, 0xc0, 0x8f, 0x17,
-	0x4b, 0xf5, 0x3e, 0x84, 0x9e, 0xc3, 0xfd, 0xad, 0x72, 0x3a, 0x9c, 0x4d, 0x37, 0xab, 0x7b, 0x69,
-	0xb4, 0x6b, 0x45, 0xfc, 0x64, 0xb1, 0x54, 0xff, 0x6f, 0x40, 0x67, 0x50, 0xd9, 0x82, 0x56, 0xf7,
-	0x85, 0xa9, 0xf7, 0x75, 0xcd, 0xb2, 0xfa, 0xc6, 0x3b, 0x43, 0xbf, 0x70, 0x8c, 0x76, 0xad, 0x84,
-	0x1b, 0x8b, 0xa5, 0xfa, 0x80, 0x0b, 0x97, 0x3e, 0x7f, 0x55, 0xa4, 0xd3, 0x57, 0xd7, 0x2b, 0x05,
-	0xdc, 0xac, 0x14, 0xf0, 0x73, 0xa5, 0x80, 0x2f, 0x6b, 0x45, 0xba, 0x59, 0x2b, 0xd2, 0xf7, 0xb5,
-	0x22, 0xbd, 0x6f, 0x6d, 0x4d, 0xdd, 0x9d, 0x88, 0x11, 0x73, 0x8f, 0x42, 0x26, 0x68, 0xf6, 0x54,
-	0x03, 0x3e, 0x9c, 0x4d, 0x18, 0xfd, 0x98, 0xcb, 0x74, 0x07, 0x83, 0x72, 0xba, 0xfe, 0x67, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xc4, 0x56, 0x61, 0xcf, 0x02, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6f, 0xda, 0x48,
+	0x18, 0xc6, 0x04, 0x08, 0x4c, 0xb4, 0x2c, 0x9a, 0x45, 0x59, 0x62, 0x69, 0x1d, 0x0b, 0x69, 0x5b,
+	0x14, 0x29, 0x76, 0x92, 0x5e, 0x7b, 0x21, 0x40, 0x1a, 0x5a, 0x02, 0xd1, 0x84, 0x28, 0x4d, 0x2f,
+	0x96, 0x31, 0x6f, 0x0d, 0x0a, 0x9e, 0xb1, 0xec, 0x01, 0x95, 0x73, 0x2f, 0x15, 0xa7, 0xfe, 0x01,
+	0x4e, 0x3d, 0xf5, 0x9f, 0xa4, 0xb7, 0x48, 0xbd, 0x54, 0x3d, 0x44, 0x55, 0xf2, 0x47, 0x2a, 0x8f,
+	0x1d, 0xea, 0xa6, 0x44, 0x3d, 0xf4, 0x84, 0x9f, 0x0f, 0xc6, 0xcf, 0xfb, 0xbc, 0xd6, 0x20, 0xd9,
+	0x05, 0xdb, 0x9e, 0xea, 0x93, 0x5d, 0xdd, 0xe4, 0x1c, 0x7c, 0x6e, 0xf2, 0x21, 0xa3, 0x9a, 0xeb,
+	0x31, 0xce, 0x70, 0x56, 0x68, 0xda, 0x64, 0x57, 0x2e, 0xda, 0xcc, 0x66, 0x82, 0xd4, 0x83, 0xa7,
+	0x50, 0x97, 0x37, 0x6c, 0xc6, 0xec, 0x11, 0xe8, 0x02, 0xf5, 0xc6, 0xaf, 0x75, 0x93, 0x4e, 0x43,
+	0xa9, 0xfc, 0x56, 0x42, 0x6b, 0xd5, 0x1f, 0x07, 0x62, 0x19, 0x65, 0x59, 0xcf, 0x07, 0x6f, 0x02,
+	0xfd, 0x92, 0xa4, 0x4a, 0x95, 0x2c, 0x59, 0x60, 0x5c, 0x44, 0xe9, 0x09, 0xe3, 0xe0, 0x97, 0x92,
+	0xea, 0x4a, 0x25, 0x47, 0x42, 0x80, 0xd7, 0x51, 0x66, 0x00, 0x43, 0x7b, 0xc0, 0x4b, 0x2b, 0xaa,
+	0x54, 0x49, 0x91, 0x08, 0xe1, 0x2d, 0x94, 0xb6, 0x46, 0xe6, 0xd0, 0x29, 0xa5, 0x54, 0xa9, 0xb2,
+	0xb6, 0x57, 0xd4, 0xc2, 0x10, 0xda, 0x5d, 0x08, 0xad, 0x4a, 0xa7, 0x24, 0xb4, 0x94, 0x3f, 0x4b,
+	0xa8, 0xd4, 0x20, 0xb5, 0xbd, 0x9d, 0x23, 0xe0, 0x66, 0xdf, 0xe4, 0x66, 0x3c, 0xd2, 0xff, 0x28,
+	0xcf, 0xd9, 0x05, 0x50, 0xc3, 0x62, 0x94, 0x7b, 0xa6, 0xc5, 0x45, 0xb0, 0x1c, 0xf9, 0x4b, 0xb0,
+	0xb5, 0x88, 0xc4, 0x18, 0xa5, 0xa8, 0xe9, 0x40, 0x29, 0x29, 0x44, 0xf1, 0x1c, 0x64, 0xf3, 0xa7,
+	0x4e, 0x8f, 0x8d, 0x44, 0xb6, 0x1c, 0x89, 0x50, 0x30, 0x65, 0x1f, 0xac, 0xa1, 0x63, 0x8e, 0x7c,
+	0x11, 0x2f, 0x45, 0x16, 0xf8, 0xa7, 0x06, 0xd2, 0x0f, 0x35, 0x90, 0x59, 0xde, 0xc0, 0x6a, 0xbc,
+	0x81, 0xb2, 0x8b, 0x90, 0x18, 0xaa, 0x1b, 0xe4, 0x0c, 0xce, 0xbd, 0x37, 0xc0, 0x02, 0xe3, 0x03,
+	0x94, 0x31, 0x1d, 0x36, 0xa6, 0x3c, 0x4c, 0xbf, 0xaf, 0x5d, 0x5e, 0x6f, 0x26, 0xbe, 0x5e, 0x6f,
+	0x3e, 0xb2, 0x87, 0x7c, 0x30, 0xee, 0x69, 0x16, 0x73, 0x74, 0x8b, 0xf9, 0x0e, 0xf3, 0xa3, 0x9f,
+	0x6d, 0xbf, 0x7f, 0xa1, 0xf3, 0xa9, 0x0b, 0xbe, 0xd6, 0xa4, 0x9c, 0x44, 0xff, 0x2e, 0x7f, 0x4a,
+	0xa2, 0x7c, 0x1d, 0x5c, 0xe6, 0x0f, 0x39, 0x01, 0x0b, 0x86, 0x2e, 0xc7, 0x9b, 0x68, 0x0d, 0x26,
+	0x40, 0xb9, 0x41, 0x19, 0xb5, 0x40, 0xbc, 0x39, 0x45, 0x90, 0xa0, 0xda, 0x01, 0x83, 0x1f, 0xa3,
+	0xbf, 0x81, 0x0f, 0xc0, 0x83, 0xb1, 0x63, 0x44, 0x63, 0x24, 0x85, 0x29, 0x7f, 0x47, 0x1f, 0x86,
+	0x0b, 0x8d, 0x1b, 0x7d, 0xa0, 0x7d, 0xf0, 0xa2, 0x56, 0x17, 0xc6, 0x13, 0xc1, 0x06, 0xc6, 0x30,
+	0xa5, 0xe1, 0x05, 0x21, 0x26, 0xe0, 0x89, 0x92, 0x73, 0x24, 0x1f, 0xd2, 0x24, 0x62, 0x97, 0x6c,
+	0x36, 0xbd, 0x6c, 0xb3, 0x45, 0x94, 0xee, 0x03, 0x65, 0x4e, 0x29, 0x23, 0xd4, 0x10, 0xc4, 0x3a,
+	0x5b, 0xfd, 0x93, 0xce, 0x62, 0xdb, 0xcb, 0xc6, 0xb7, 0xb7, 0xf5, 0x31, 0x89, 0x72, 0xb5, 0xe0,
+	0xeb, 0xec, 0x4e, 0x5d, 0xc0, 0x1a, 0xc2, 0xb5, 0x56, 0xb5, 0x79, 0x64, 0x74, 0xcf, 0x8f, 0x1b,
+	0xc6, 0x69, 0xfb, 0x45, 0xbb, 0x73, 0xd6, 0x2e, 0x24, 0xe4, 0xf5, 0xd9, 0x5c, 0x5d, 0xa2, 0xdc,
+	0xf3, 0xd7, 0x1b, 0xc7, 0x9d, 0x93, 0x66, 0xb7, 0x20, 0xfd, 0xe2, 0x8f, 0x14, 0xbc, 0x83, 0xfe,
+	0x89, 0xb1, 0x67, 0xcd, 0xee, 0x61, 0x9d, 0x54, 0xcf, 0x0a, 0x49, 0xf9, 0xdf, 0xd9, 0x5c, 0x5d,
+	0x26, 0xe1, 0xa7, 0x68, 0x23, 0x46, 0x8b, 0x0f, 0x2d, 0x38, 0xad, 0xd5, 0x39, 0x6f, 0xd4, 0x0b,
+	0x2b, 0xf2, 0x7f, 0xb3, 0xb9, 0xfa, 0xb0, 0x01, 0x1f, 0x20, 0x25, 0x26, 0xb6, 0x3a, 0xcf, 0x9a,
+	0x35, 0xa3, 0x56, 0x6d, 0xb5, 0x8c, 0xc6, 0xcb, 0x46, 0xed, 0xb4, 0xdb, 0xa8, 0x17, 0x52, 0x72,
+	0x79, 0x36, 0x57, 0x7f, 0xe3, 0x92, 0x53, 0xef, 0x3e, 0x28, 0x89, 0xfd, 0xe7, 0x97, 0x37, 0x8a,
+	0x74, 0x75, 0xa3, 0x48, 0xdf, 0x6e, 0x14, 0xe9, 0xfd, 0xad, 0x92, 0xb8, 0xba, 0x55, 0x12, 0x5f,
+	0x6e, 0x95, 0xc4, 0xab, 0x9d, 0xd8, 0x36, 0xcc, 0x11, 0x1f, 0x80, 0xb9, 0x4d, 0x81, 0xeb, 0xe1,
+	0x65, 0xe6, 0xb0, 0xfe, 0x78, 0x04, 0xfa, 0x9b, 0x08, 0x8a, 0xdd, 0xf4, 0x32, 0xe2, 0x82, 0x78,
+	0xf2, 0x7d, 0x00, 0x26, 0xd6, 0x19, 0xc2, 0xf1, 0x04, 0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -294,6 +503,79 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ERC20MetadataAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20MetadataAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20MetadataAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Votes[iNdEx])
+			copy(dAtA[i:], m.Votes[iNdEx])
+			i = encodeVarintAttestation(dAtA, i, uint64(len(m.Votes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Observed {
+		i--
+		if m.Observed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -334,6 +616,82 @@ func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DepositReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAttestation(v)
 	base := offset
@@ -370,6 +728,42 @@ func (m *Attestation) Size() (n int) {
 	return n
 }
 
+func (m *ERC20MetadataAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovAttestation(uint64(m.Decimals))
+	}
+	if m.Observed {
+		n += 2
+	}
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *ERC20Token) Size() (n int) {
 	if m == nil {
 		return 0
@@ -385,17 +779,53 @@ func (m *ERC20Token) Size() (n int) {
 	return n
 }
 
-func sovAttestation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAttestation(x uint64) (n int) {
-	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Attestation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *DepositReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.EthereumHeight))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -551,7 +981,7 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+func (m *ERC20MetadataAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -574,15 +1004,15 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20MetadataAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20MetadataAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -610,11 +1040,11 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -642,10 +1072,521 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Observed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
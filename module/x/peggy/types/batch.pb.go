@@ -185,6 +185,8 @@ type OutgoingLogicCall struct {
 	Timeout              uint64        `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	InvalidationId       []byte        `protobuf:"bytes,6,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce    uint64        `protobuf:"varint,7,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Block                uint64        `protobuf:"varint,8,opt,name=block,proto3" json:"block,omitempty"`
+	SourceModule         string        `protobuf:"bytes,9,opt,name=source_module,json=sourceModule,proto3" json:"source_module,omitempty"`
 }
 
 func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
@@ -269,6 +271,20 @@ func (m *OutgoingLogicCall) GetInvalidationNonce() uint64 {
 	return 0
 }
 
+func (m *OutgoingLogicCall) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *OutgoingLogicCall) GetSourceModule() string {
+	if m != nil {
+		return m.SourceModule
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "peggy.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "peggy.v1.OutgoingTransferTx")
@@ -278,39 +294,40 @@ func init() {
 func init() { proto.RegisterFile("peggy/v1/batch.proto", fileDescriptor_398e85e0d69cec73) }
 
 var fileDescriptor_398e85e0d69cec73 = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xd3, 0x34, 0x6d, 0x26, 0x69, 0xaa, 0xae, 0xa2, 0xca, 0xaa, 0x90, 0x09, 0x41, 0x88,
-	0x5c, 0x9a, 0x3f, 0xe0, 0x0e, 0x8d, 0x40, 0x02, 0x21, 0x90, 0xac, 0x9c, 0xb8, 0x44, 0x1b, 0xef,
-	0xc4, 0x59, 0xd5, 0xd9, 0x8d, 0xec, 0x4d, 0x94, 0xbc, 0x05, 0xef, 0xc2, 0x4b, 0xc0, 0xad, 0x27,
-	0xc4, 0x11, 0x25, 0x2f, 0x82, 0x76, 0x6c, 0xa7, 0x2d, 0x7f, 0x37, 0xcf, 0xf7, 0x7d, 0x33, 0xf3,
-	0xcd, 0x78, 0x16, 0x1a, 0x0b, 0x0c, 0xc3, 0x4d, 0x77, 0xd5, 0xef, 0x4e, 0xb8, 0x09, 0x66, 0x9d,
-	0x45, 0xac, 0x8d, 0x66, 0xc7, 0x84, 0x76, 0x56, 0xfd, 0x8b, 0x8b, 0x3d, 0xcf, 0x8d, 0xc1, 0xc4,
-	0x70, 0x23, 0xb5, 0x4a, 0x55, 0xad, 0xef, 0x0e, 0x9c, 0x7e, 0x5c, 0x9a, 0x50, 0x4b, 0x15, 0x8e,
-	0xd6, 0x57, 0x36, 0x9f, 0x3d, 0x84, 0x2a, 0x15, 0x1a, 0x2b, 0xad, 0x02, 0x74, 0x9d, 0xa6, 0xd3,
-	0x2e, 0xf9, 0x40, 0xd0, 0x07, 0x8b, 0xb0, 0xc7, 0x70, 0x92, 0x0a, 0x8c, 0x9c, 0xa3, 0x5e, 0x1a,
-	0xb7, 0x48, 0x92, 0x1a, 0x81, 0xa3, 0x14, 0x63, 0x2f, 0xa1, 0x66, 0x62, 0xae, 0x12, 0x1e, 0xd8,
-	0x76, 0x89, 0x7b, 0xd0, 0x3c, 0x68, 0x57, 0x07, 0x0f, 0x3a, 0xb9, 0xad, 0xce, 0xbe, 0xad, 0x55,
-	0x4d, 0x31, 0x1e, 0xad, 0xfd, 0x7b, 0x19, 0xec, 0x09, 0xd4, 0x8d, 0xbe, 0x46, 0x35, 0x0e, 0xb4,
-	0x32, 0x31, 0x0f, 0x8c, 0x5b, 0x6a, 0x3a, 0xed, 0x8a, 0x7f, 0x42, 0xe8, 0x30, 0x03, 0x59, 0x03,
-	0x0e, 0x27, 0x91, 0x0e, 0xae, 0xdd, 0x43, 0x72, 0x91, 0x06, 0xad, 0x6f, 0x0e, 0xb0, 0x3f, 0x3b,
-	0xb0, 0x3a, 0x14, 0xa5, 0xc8, 0x46, 0x2a, 0x4a, 0xc1, 0xce, 0xa1, 0x9c, 0xa0, 0x12, 0x18, 0xd3,
-	0x0c, 0x15, 0x3f, 0x8b, 0xd8, 0x23, 0xa8, 0x09, 0x4c, 0xcc, 0x98, 0x0b, 0x11, 0x63, 0x62, 0xdd,
-	0x5b, 0xb6, 0x6a, 0xb1, 0x57, 0x29, 0xc4, 0x5e, 0x40, 0x15, 0xe3, 0x60, 0xd0, 0x1b, 0x93, 0x1d,
-	0xf2, 0x56, 0x1d, 0x34, 0x6e, 0xe7, 0x7b, 0xed, 0x0f, 0x07, 0xbd, 0x91, 0xe5, 0x7c, 0x20, 0x21,
-	0x7d, 0xb3, 0x3e, 0x54, 0xd2, 0xb4, 0x29, 0x22, 0x59, 0xfe, 0x57, 0xd2, 0x31, 0xc9, 0xde, 0x20,
-	0xb6, 0xbe, 0x14, 0xe1, 0x2c, 0x9f, 0xe5, 0xbd, 0x0e, 0x65, 0x30, 0xe4, 0x51, 0xc4, 0x06, 0x50,
-	0x31, 0xd9, 0x60, 0x89, 0xeb, 0xd0, 0x76, 0xff, 0x5e, 0xe8, 0x56, 0xc6, 0xda, 0x50, 0x9a, 0x22,
-	0x26, 0x6e, 0xf1, 0x3f, 0x72, 0x52, 0xb0, 0xe7, 0x70, 0x1e, 0xd9, 0x56, 0xfb, 0xe5, 0xff, 0xb6,
-	0x8a, 0x06, 0xb1, 0xf9, 0x4f, 0xc8, 0x77, 0xe2, 0xc2, 0xd1, 0x82, 0x6f, 0x22, 0xcd, 0x05, 0xed,
-	0xa3, 0xe6, 0xe7, 0xa1, 0x65, 0xf2, 0x6b, 0x49, 0xff, 0x53, 0x1e, 0xb2, 0xa7, 0x70, 0x2a, 0xd5,
-	0x8a, 0x47, 0x52, 0xd0, 0x61, 0x8e, 0xa5, 0x70, 0xcb, 0x94, 0x5b, 0xbf, 0x0b, 0xbf, 0x15, 0xec,
-	0x12, 0xd8, 0x3d, 0x61, 0x7a, 0x9e, 0x47, 0x54, 0xed, 0xec, 0x2e, 0x43, 0x57, 0x7a, 0xf5, 0xee,
-	0xeb, 0xd6, 0x73, 0x6e, 0xb6, 0x9e, 0xf3, 0x73, 0xeb, 0x39, 0x9f, 0x77, 0x5e, 0xe1, 0x66, 0xe7,
-	0x15, 0x7e, 0xec, 0xbc, 0xc2, 0xa7, 0x5e, 0x28, 0xcd, 0x6c, 0x39, 0xe9, 0x04, 0x7a, 0xde, 0xe5,
-	0x91, 0x99, 0x21, 0xbf, 0x54, 0x68, 0xba, 0xe9, 0x33, 0x99, 0x6b, 0xb1, 0x8c, 0xb0, 0xbb, 0xce,
-	0x42, 0xb3, 0x59, 0x60, 0x32, 0x29, 0xd3, 0x6b, 0x79, 0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xda,
-	0x0f, 0x44, 0xc9, 0x6b, 0x03, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4b, 0x6f, 0xda, 0x4c,
+	0x14, 0xc5, 0xbc, 0x02, 0x17, 0x42, 0x94, 0x11, 0x8a, 0xac, 0xe8, 0x93, 0x3f, 0x4a, 0x55, 0x95,
+	0x4d, 0x78, 0xb5, 0xdd, 0xb7, 0x41, 0xad, 0xd4, 0xaa, 0x0f, 0xc9, 0x62, 0xd5, 0x8d, 0x35, 0x78,
+	0x2e, 0xc6, 0x8a, 0xf1, 0x20, 0x7b, 0x40, 0xf0, 0x2f, 0xfa, 0xb3, 0xda, 0x5d, 0x56, 0x55, 0x97,
+	0x15, 0xec, 0xfb, 0x1b, 0xaa, 0xb9, 0x83, 0x09, 0x69, 0xd5, 0xec, 0x7c, 0xcf, 0x3d, 0xf7, 0x71,
+	0xae, 0xcf, 0x40, 0x73, 0x81, 0x41, 0xb0, 0xe9, 0xad, 0x06, 0xbd, 0x09, 0x57, 0xfe, 0xac, 0xbb,
+	0x48, 0xa4, 0x92, 0xac, 0x42, 0x68, 0x77, 0x35, 0xb8, 0xbc, 0x3c, 0xe4, 0xb9, 0x52, 0x98, 0x2a,
+	0xae, 0x42, 0x19, 0x1b, 0x56, 0xfb, 0xbb, 0x05, 0x67, 0x9f, 0x96, 0x2a, 0x90, 0x61, 0x1c, 0x8c,
+	0xd7, 0xd7, 0xba, 0x9e, 0xfd, 0x0f, 0x35, 0x6a, 0xe4, 0xc5, 0x32, 0xf6, 0xd1, 0xb6, 0x5a, 0x56,
+	0xa7, 0xe8, 0x02, 0x41, 0x1f, 0x35, 0xc2, 0x1e, 0xc3, 0xa9, 0x21, 0xa8, 0x70, 0x8e, 0x72, 0xa9,
+	0xec, 0x3c, 0x51, 0xea, 0x04, 0x8e, 0x0d, 0xc6, 0x5e, 0x42, 0x5d, 0x25, 0x3c, 0x4e, 0xb9, 0xaf,
+	0xc7, 0xa5, 0x76, 0xa1, 0x55, 0xe8, 0xd4, 0x86, 0xff, 0x75, 0xb3, 0xb5, 0xba, 0x87, 0xb1, 0x9a,
+	0x35, 0xc5, 0x64, 0xbc, 0x76, 0xef, 0x55, 0xb0, 0x27, 0xd0, 0x50, 0xf2, 0x06, 0x63, 0xcf, 0x97,
+	0xb1, 0x4a, 0xb8, 0xaf, 0xec, 0x62, 0xcb, 0xea, 0x54, 0xdd, 0x53, 0x42, 0x47, 0x7b, 0x90, 0x35,
+	0xa1, 0x34, 0x89, 0xa4, 0x7f, 0x63, 0x97, 0x68, 0x0b, 0x13, 0xb4, 0xbf, 0x59, 0xc0, 0xfe, 0x9e,
+	0xc0, 0x1a, 0x90, 0x0f, 0xc5, 0x5e, 0x52, 0x3e, 0x14, 0xec, 0x02, 0xca, 0x29, 0xc6, 0x02, 0x13,
+	0xd2, 0x50, 0x75, 0xf7, 0x11, 0x7b, 0x04, 0x75, 0x81, 0xa9, 0xf2, 0xb8, 0x10, 0x09, 0xa6, 0x7a,
+	0x7b, 0x9d, 0xad, 0x69, 0xec, 0x95, 0x81, 0xd8, 0x0b, 0xa8, 0x61, 0xe2, 0x0f, 0xfb, 0x1e, 0xad,
+	0x43, 0xbb, 0xd5, 0x86, 0xcd, 0x3b, 0x7d, 0xaf, 0xdd, 0xd1, 0xb0, 0x3f, 0xd6, 0x39, 0x17, 0x88,
+	0x48, 0xdf, 0x6c, 0x00, 0x55, 0x53, 0x36, 0x45, 0xb4, 0x4b, 0x0f, 0x14, 0x55, 0x88, 0xf6, 0x06,
+	0xb1, 0xfd, 0x2b, 0x0f, 0xe7, 0x99, 0x96, 0xf7, 0x32, 0x08, 0xfd, 0x11, 0x8f, 0x22, 0x36, 0x84,
+	0xaa, 0xda, 0x0b, 0x4b, 0x6d, 0xab, 0x55, 0xf8, 0x67, 0xa3, 0x3b, 0x1a, 0xeb, 0x40, 0x71, 0x8a,
+	0x98, 0xda, 0xf9, 0x07, 0xe8, 0xc4, 0x60, 0xcf, 0xe1, 0x22, 0xd2, 0xa3, 0x0e, 0xc7, 0xff, 0xe3,
+	0x14, 0x4d, 0xca, 0x66, 0x3f, 0x21, 0xbb, 0x89, 0x0d, 0x27, 0x0b, 0xbe, 0x89, 0x24, 0x17, 0x74,
+	0x8f, 0xba, 0x9b, 0x85, 0x3a, 0x93, 0xb9, 0xc5, 0xfc, 0xa7, 0x2c, 0x64, 0x4f, 0xe1, 0x2c, 0x8c,
+	0x57, 0x3c, 0x0a, 0x05, 0x19, 0xd3, 0x0b, 0x85, 0x5d, 0xa6, 0xda, 0xc6, 0x31, 0xfc, 0x56, 0xb0,
+	0x2b, 0x60, 0xf7, 0x88, 0xc6, 0x9e, 0x27, 0xd4, 0xed, 0xfc, 0x38, 0x63, 0x5c, 0x7a, 0xf0, 0x45,
+	0xe5, 0xc8, 0x17, 0xda, 0xbb, 0xa9, 0x5c, 0x26, 0x3e, 0x7a, 0x73, 0x29, 0x96, 0x11, 0xda, 0x55,
+	0x92, 0x53, 0x37, 0xe0, 0x07, 0xc2, 0xae, 0xdf, 0x7d, 0xdd, 0x3a, 0xd6, 0xed, 0xd6, 0xb1, 0x7e,
+	0x6e, 0x1d, 0xeb, 0xcb, 0xce, 0xc9, 0xdd, 0xee, 0x9c, 0xdc, 0x8f, 0x9d, 0x93, 0xfb, 0xdc, 0x0f,
+	0x42, 0x35, 0x5b, 0x4e, 0xba, 0xbe, 0x9c, 0xf7, 0x78, 0xa4, 0x66, 0xc8, 0xaf, 0x62, 0x54, 0x3d,
+	0xf3, 0xc2, 0x4c, 0xc7, 0xde, 0x7a, 0x1f, 0xaa, 0xcd, 0x02, 0xd3, 0x49, 0x99, 0x1e, 0xda, 0xb3,
+	0xdf, 0x03, 0x00, 0x37, 0x49, 0x12, 0x47, 0xa6, 0x03, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -458,6 +475,18 @@ func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceModule) > 0 {
+		i -= len(m.SourceModule)
+		copy(dAtA[i:], m.SourceModule)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.SourceModule)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Block != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.InvalidationNonce != 0 {
 		i = encodeVarintBatch(dAtA, i, uint64(m.InvalidationNonce))
 		i--
@@ -623,6 +652,13 @@ func (m *OutgoingLogicCall) Size() (n int) {
 	if m.InvalidationNonce != 0 {
 		n += 1 + sovBatch(uint64(m.InvalidationNonce))
 	}
+	if m.Block != 0 {
+		n += 1 + sovBatch(uint64(m.Block))
+	}
+	l = len(m.SourceModule)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
 	return n
 }
 
@@ -1251,6 +1287,57 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -25,7 +26,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawClaim{},
 		&MsgERC20DeployedClaim{},
 		&MsgSetOrchestratorAddress{},
+		&MsgRotateDelegateKeys{},
+		&MsgSweepFallbackAccount{},
 		&MsgLogicCallExecutedClaim{},
+		&MsgERC20MetadataClaim{},
 	)
 
 	registry.RegisterInterface(
@@ -34,6 +38,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDepositClaim{},
 		&MsgWithdrawClaim{},
 		&MsgERC20DeployedClaim{},
+		&MsgLogicCallExecutedClaim{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&LogicCallProposal{},
+		&ERC20DeploymentProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -43,6 +53,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*EthereumClaim)(nil), nil)
 	cdc.RegisterConcrete(&MsgSetOrchestratorAddress{}, "peggy/MsgSetOrchestratorAddress", nil)
+	cdc.RegisterConcrete(&MsgRotateDelegateKeys{}, "peggy/MsgRotateDelegateKeys", nil)
+	cdc.RegisterConcrete(&MsgSweepFallbackAccount{}, "peggy/MsgSweepFallbackAccount", nil)
 	cdc.RegisterConcrete(&MsgValsetConfirm{}, "peggy/MsgValsetConfirm", nil)
 	cdc.RegisterConcrete(&MsgSendToEth{}, "peggy/MsgSendToEth", nil)
 	cdc.RegisterConcrete(&MsgRequestBatch{}, "peggy/MsgRequestBatch", nil)
@@ -52,9 +64,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawClaim{}, "peggy/MsgWithdrawClaim", nil)
 	cdc.RegisterConcrete(&MsgERC20DeployedClaim{}, "peggy/MsgERC20DeployedClaim", nil)
 	cdc.RegisterConcrete(&MsgLogicCallExecutedClaim{}, "peggy/MsgLogicCallExecutedClaim", nil)
+	cdc.RegisterConcrete(&MsgERC20MetadataClaim{}, "peggy/MsgERC20MetadataClaim", nil)
 	cdc.RegisterConcrete(&OutgoingTxBatch{}, "peggy/OutgoingTxBatch", nil)
 	cdc.RegisterConcrete(&OutgoingTransferTx{}, "peggy/OutgoingTransferTx", nil)
 	cdc.RegisterConcrete(&ERC20Token{}, "peggy/ERC20Token", nil)
 	cdc.RegisterConcrete(&IDSet{}, "peggy/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "peggy/Attestation", nil)
+	cdc.RegisterConcrete(&LogicCallProposal{}, "peggy/LogicCallProposal", nil)
+	cdc.RegisterConcrete(&ERC20DeploymentProposal{}, "peggy/ERC20DeploymentProposal", nil)
 }
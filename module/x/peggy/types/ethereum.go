@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -29,7 +30,8 @@ func EthAddrLessThan(e, o string) bool {
 	return bytes.Compare([]byte(e)[:], []byte(o)[:]) == -1
 }
 
-// ValidateEthAddress validates the ethereum address strings
+// ValidateEthAddress validates the ethereum address strings, mixed case addresses have to
+// carry a valid EIP-55 checksum
 func ValidateEthAddress(a string) error {
 	if a == "" {
 		return fmt.Errorf("empty")
@@ -40,9 +42,33 @@ func ValidateEthAddress(a string) error {
 	if len(a) != ETHContractAddressLen {
 		return fmt.Errorf("address(%s) of the wrong length exp(%d) actual(%d)", a, len(a), ETHContractAddressLen)
 	}
+	if hex := a[2:]; hex != strings.ToLower(hex) && hex != strings.ToUpper(hex) && gethcommon.HexToAddress(a).Hex() != a {
+		return fmt.Errorf("address(%s) doesn't pass EIP-55 checksum", a)
+	}
 	return nil
 }
 
+// NormalizeEthAddress returns the EIP-55 checksummed form of an ethereum address, the module
+// stores addresses in this form so that one address never shows up in different casings.
+// Invalid addresses are returned unchanged.
+func NormalizeEthAddress(a string) string {
+	if !gethcommon.IsHexAddress(a) || !strings.HasPrefix(a, "0x") {
+		return a
+	}
+	return gethcommon.HexToAddress(a).Hex()
+}
+
+// EthAddressBytes returns the 20 bytes of an Ethereum address, store keys use them so that
+// they have a fixed length and do not depend on the casing of the address
+func EthAddressBytes(a string) []byte {
+	return gethcommon.HexToAddress(a).Bytes()
+}
+
+// EthAddressFromBytes returns the checksummed Ethereum address of 20 address bytes
+func EthAddressFromBytes(bz []byte) string {
+	return gethcommon.BytesToAddress(bz).Hex()
+}
+
 /////////////////////////
 //     ERC20Token      //
 /////////////////////////
@@ -61,8 +87,20 @@ func (e *ERC20Token) PeggyCoin() sdk.Coin {
 	return sdk.NewCoin(PeggyDenom(e.Contract), e.Amount)
 }
 
+// PeggyDenom returns the denom of the vouchers of an Ethereum originated ERC20
 func PeggyDenom(tokenContract string) string {
-	return fmt.Sprintf("%s%s%s", PeggyDenomPrefix, PeggyDenomSeparator, tokenContract)
+	return fmt.Sprintf("%s%s%s", PeggyDenomPrefix, PeggyDenomSeparator, NormalizeEthAddress(tokenContract))
+}
+
+// NormalizePeggyDenom returns the checksummed denom of a peggy voucher denom in any casing, other
+// denoms are returned unchanged
+func NormalizePeggyDenom(denom string) string {
+	contract := strings.TrimPrefix(denom, PeggyDenomPrefix+PeggyDenomSeparator)
+	if len(denom) != PeggyDenomLen || contract == denom ||
+		!strings.HasPrefix(contract, "0x") || !gethcommon.IsHexAddress(contract) {
+		return denom
+	}
+	return PeggyDenom(contract)
 }
 
 // ValidateBasic permforms stateless validation
@@ -100,6 +138,6 @@ func PeggyDenomToERC20(denom string) (string, error) {
 	case len(denom) != PeggyDenomLen:
 		return "", fmt.Errorf("len(denom)(%d) not equal to PeggyDenomLen(%d)", len(denom), PeggyDenomLen)
 	default:
-		return contract, nil
+		return NormalizeEthAddress(contract), nil
 	}
 }
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEthAddress(t *testing.T) {
+	const checksummed = "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+	specs := map[string]struct {
+		src    string
+		exp    string
+		expErr bool
+	}{
+		"checksummed":  {src: checksummed, exp: checksummed},
+		"lower case":   {src: "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e", exp: checksummed},
+		"upper case":   {src: "0x0BC529C00C6401AEF6D220BE8C6EA1667F6AD93E", exp: checksummed},
+		"bad checksum": {src: "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93E", exp: checksummed, expErr: true},
+		"no prefix":    {src: "0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e00", exp: "0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e00", expErr: true},
+		"not hex":      {src: "0xzzc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", exp: "0xzzc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, NormalizeEthAddress(spec.src))
+			assert.Equal(t, spec.expErr, ValidateEthAddress(spec.src) != nil)
+		})
+	}
+	// vouchers of an ERC20 have one denom regardless of the casing of its address
+	assert.Equal(t, "peggy"+checksummed, PeggyDenom("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"))
+}
@@ -7,8 +7,18 @@ const (
 	EventTypeMultisigUpdateRequest     = "multisig_update_request"
 	EventTypeOutgoingBatchCanceled     = "outgoing_batch_canceled"
 	EventTypeOutgoingLogicCallCanceled = "outgoing_logic_call_canceled"
+	EventTypeOutgoingLogicCall         = "outgoing_logic_call"
+	EventTypeOutgoingLogicCallExecuted = "outgoing_logic_call_executed"
+	EventTypeERC20DeploymentRequest    = "erc20_deployment_request"
+	EventTypeERC20Metadata             = "erc20_metadata"
 	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
 	EventTypeBridgeDepositReceived     = "deposit_received"
+	EventTypeDepositForwarded          = "deposit_forwarded"
+	EventTypeDepositForwardFailed      = "deposit_forward_failed"
+	EventTypeFallbackAccountSwept      = "fallback_account_swept"
+	EventTypeIBCSendToEth              = "ibc_send_to_eth"
+	EventTypeDelegateKeyRotation       = "delegate_key_rotation"
+	EventTypeValidatorSlashed          = "validator_slashed"
 
 	AttributeKeyAttestationID     = "attestation_id"
 	AttributeKeyAttestationIDs    = "attestation_ids"
@@ -26,4 +36,25 @@ const (
 	AttributeKeySetOperatorAddr   = "set_operator_address"
 	AttributeKeyInvalidationID    = "logic_call_invalidation_id"
 	AttributeKeyInvalidationNonce = "logic_call_invalidation_nonce"
+	AttributeKeyValidator         = "validator"
+	AttributeKeyOrchestrator      = "orchestrator"
+	AttributeKeyEthAddress        = "eth_address"
+	AttributeKeyEffectiveHeight   = "effective_height"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeySourceModule      = "source_module"
+	AttributeKeyLogicContract     = "logic_contract"
+	AttributeKeyCosmosDenom       = "cosmos_denom"
+	AttributeKeyERC20Token        = "erc20_token"
+	AttributeKeyERC20Name         = "erc20_name"
+	AttributeKeyERC20Symbol       = "erc20_symbol"
+	AttributeKeyERC20Decimals     = "erc20_decimals"
+	AttributeKeyIBCBaseDenom      = "ibc_base_denom"
+	AttributeKeyIBCPath           = "ibc_path"
+	AttributeKeyForwardChannel    = "forward_channel"
+	AttributeKeyForwardReceiver   = "forward_receiver"
+	AttributeKeyFallbackReceiver  = "fallback_receiver"
+	AttributeKeyError             = "error"
+	AttributeKeyEthereumSender    = "ethereum_sender"
+	AttributeKeyCosmosReceiver    = "cosmos_receiver"
+	AttributeKeyEthereumHeight    = "ethereum_height"
 )
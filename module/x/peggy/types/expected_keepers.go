@@ -2,8 +2,13 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 // StakingKeeper defines the expected staking keeper methods
@@ -24,8 +29,43 @@ type StakingKeeper interface {
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetDenomMetaData(ctx sdk.Context, denom string) bank.Metadata
+	SetDenomMetaData(ctx sdk.Context, denomMetaData bank.Metadata)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(bank.Metadata) bool)
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+}
+
+// DistributionKeeper defines the expected distribution keeper methods
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
+}
+
+// IBCTransferKeeper defines the expected IBC transfer keeper methods
+type IBCTransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+	SendTransfer(
+		ctx sdk.Context,
+		sourcePort,
+		sourceChannel string,
+		token sdk.Coin,
+		sender sdk.AccAddress,
+		receiver string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+	) error
+}
+
+// LogicCallHooks are called back by peggy for the logic calls created by a module
+type LogicCallHooks interface {
+	AfterLogicCallExecuted(ctx sdk.Context, call OutgoingLogicCall)
+	// AfterLogicCallCanceled is called once the tokens of a call that timed out or was
+	// invalidated have been refunded to the module account
+	AfterLogicCallCanceled(ctx sdk.Context, call OutgoingLogicCall)
 }
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForwardingSeparator separates the channel from the receiver on the remote chain in
+// the Cosmos receiver of a deposit that is forwarded over IBC, e.g. channel-0/osmo1...
+const ForwardingSeparator = "/"
+
+// ParseForwardingReceiver splits the Cosmos receiver of a deposit in the forwarding format
+// into the channel to forward the deposit through and the receiver on the remote chain,
+// forward is false for receivers on this chain
+func ParseForwardingReceiver(receiver string) (channel string, remoteReceiver string, forward bool) {
+	parts := strings.SplitN(receiver, ForwardingSeparator, 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// ValidateCosmosReceiver checks the Cosmos receiver of a deposit is either an account on
+// this chain or an account on another chain in the forwarding format
+func ValidateCosmosReceiver(receiver string) error {
+	channel, remoteReceiver, forward := ParseForwardingReceiver(receiver)
+	if !forward {
+		if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, receiver)
+		}
+		return nil
+	}
+	if err := host.ChannelIdentifierValidator(channel); err != nil {
+		return sdkerrors.Wrap(err, "forwarding channel")
+	}
+	// the remote chain uses its own bech32 prefix
+	if _, _, err := bech32.DecodeAndConvert(remoteReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, remoteReceiver)
+	}
+	return nil
+}
+
+// EthereumSenderAccount returns the local account deposits of an Ethereum sender fall back to
+// when they can not be forwarded, it has the same 20 bytes as the Ethereum address. No Cosmos
+// key controls it, its coins are moved with a MsgSweepFallbackAccount the Ethereum key signs
+func EthereumSenderAccount(ethereumSender string) sdk.AccAddress {
+	return sdk.AccAddress(common.HexToAddress(ethereumSender).Bytes())
+}
+
+// SendToEthInstruction is given as the receiver of an ICS-20 transfer in the form
+// {bech32 receiver}/{ethereum destination}/{bridge fee} to send the transferred tokens
+// on to Ethereum. The bridge fee is paid in the transferred denom.
+type SendToEthInstruction struct {
+	Receiver  sdk.AccAddress
+	EthDest   string
+	BridgeFee sdk.Int
+}
+
+// ParseSendToEthInstruction parses the receiver of an ICS-20 transfer, it returns nil
+// for plain receivers
+func ParseSendToEthInstruction(receiver string) (*SendToEthInstruction, error) {
+	if !strings.Contains(receiver, ForwardingSeparator) {
+		return nil, nil
+	}
+	parts := strings.Split(receiver, ForwardingSeparator)
+	if len(parts) != 3 {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "send to eth instruction %s", receiver)
+	}
+	addr, err := sdk.AccAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, parts[0])
+	}
+	if err := ValidateEthAddress(parts[1]); err != nil {
+		return nil, sdkerrors.Wrap(err, "eth destination")
+	}
+	fee, ok := sdk.NewIntFromString(parts[2])
+	if !ok || fee.IsNegative() {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "bridge fee %s", parts[2])
+	}
+	return &SendToEthInstruction{Receiver: addr, EthDest: parts[1], BridgeFee: fee}, nil
+}
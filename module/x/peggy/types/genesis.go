@@ -2,10 +2,12 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -67,16 +69,511 @@ var (
 	// ParamsStoreSlashFractionConflictingClaim stores the slash fraction ConflictingClaim
 	ParamsStoreSlashFractionConflictingClaim = []byte("SlashFractionConflictingClaim")
 
+	// ParamsStoreDelegateKeyRotationGracePeriod stores the delegate key rotation grace period
+	ParamsStoreDelegateKeyRotationGracePeriod = []byte("DelegateKeyRotationGracePeriod")
+
+	// ParamsStoreIBCForwardTimeout stores the timeout of ICS-20 transfers forwarding deposits
+	ParamsStoreIBCForwardTimeout = []byte("IBCForwardTimeout")
+
+	// ParamsStoreDepositReceiptRetention stores the number of blocks deposit receipts are kept for
+	ParamsStoreDepositReceiptRetention = []byte("DepositReceiptRetention")
+
+	// ParamsStoreTransferRecordRetention stores the number of blocks transfer records are kept for
+	ParamsStoreTransferRecordRetention = []byte("TransferRecordRetention")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
 
-// ValidateBasic validates genesis state by looping through the params and
-// calling their validation functions
+// ValidateBasic validates genesis state by validating the params and every section
+// of the state along with the references between the sections, so that a genesis
+// state passing it can be imported by InitGenesis
 func (s GenesisState) ValidateBasic() error {
+	if s.Params == nil {
+		return sdkerrors.Wrap(ErrEmpty, "params")
+	}
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	if err := s.validateValsets(); err != nil {
+		return sdkerrors.Wrap(err, "valsets")
+	}
+	if err := s.validateOutgoingPool(); err != nil {
+		return sdkerrors.Wrap(err, "outgoing pool")
+	}
+	if err := s.validateBatches(); err != nil {
+		return sdkerrors.Wrap(err, "batches")
+	}
+	if err := s.validateLogicCalls(); err != nil {
+		return sdkerrors.Wrap(err, "logic calls")
+	}
+	if err := s.validateAttestations(); err != nil {
+		return sdkerrors.Wrap(err, "attestations")
+	}
+	if err := s.validateDelegateKeys(); err != nil {
+		return sdkerrors.Wrap(err, "delegate keys")
+	}
+	if err := s.validateValidatorRecords(); err != nil {
+		return sdkerrors.Wrap(err, "validator records")
+	}
+	if err := s.validateTokens(); err != nil {
+		return sdkerrors.Wrap(err, "tokens")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range s.Attestations {
+		if err := s.Attestations[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSignature checks an ethereum signature is hex encoded and long enough
+func validateSignature(signature string) error {
+	bz, err := hex.DecodeString(signature)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not hex decode signature: %s", signature)
+	}
+	if len(bz) < 65 {
+		return sdkerrors.Wrapf(ErrInvalid, "signature too short: %s", signature)
+	}
+	return nil
+}
+
+func (s GenesisState) validateValsets() error {
+	valsets := make(map[uint64]bool, len(s.Valsets))
+	for _, vs := range s.Valsets {
+		if vs == nil {
+			return sdkerrors.Wrap(ErrEmpty, "valset")
+		}
+		if valsets[vs.Nonce] {
+			return sdkerrors.Wrapf(ErrDuplicate, "valset nonce %d", vs.Nonce)
+		}
+		valsets[vs.Nonce] = true
+		if err := vs.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "valset %d", vs.Nonce)
+		}
+	}
+
+	confirms := make(map[string]bool, len(s.ValsetConfirms))
+	for _, conf := range s.ValsetConfirms {
+		if conf == nil {
+			return sdkerrors.Wrap(ErrEmpty, "valset confirm")
+		}
+		if err := conf.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "valset %d confirm", conf.Nonce)
+		}
+		if err := validateSignature(conf.Signature); err != nil {
+			return sdkerrors.Wrapf(err, "valset %d confirm", conf.Nonce)
+		}
+		if !valsets[conf.Nonce] {
+			return sdkerrors.Wrapf(ErrUnknown, "valset %d of confirm by %s", conf.Nonce, conf.Orchestrator)
+		}
+		key := fmt.Sprintf("%d/%s", conf.Nonce, conf.Orchestrator)
+		if confirms[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "valset %d confirm by %s", conf.Nonce, conf.Orchestrator)
+		}
+		confirms[key] = true
+	}
+	return nil
+}
+
+func (s GenesisState) validateOutgoingPool() error {
+	pool := make(map[uint64]bool, len(s.OutgoingPool))
+	for _, entry := range s.OutgoingPool {
+		if entry.Id == 0 || entry.Id > s.LastTxPoolId {
+			return sdkerrors.Wrapf(ErrInvalid, "tx id %d, last tx id is %d", entry.Id, s.LastTxPoolId)
+		}
+		if pool[entry.Id] {
+			return sdkerrors.Wrapf(ErrDuplicate, "tx id %d", entry.Id)
+		}
+		pool[entry.Id] = true
+		if _, err := sdk.AccAddressFromBech32(entry.Tx.Sender); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "tx %d sender %s", entry.Id, entry.Tx.Sender)
+		}
+		if err := ValidateEthAddress(entry.Tx.DestAddr); err != nil {
+			return sdkerrors.Wrapf(err, "tx %d destination", entry.Id)
+		}
+		if !entry.Tx.Amount.IsValid() || !entry.Tx.BridgeFee.IsValid() || entry.Tx.Amount.Denom != entry.Tx.BridgeFee.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "tx %d amount %s fee %s", entry.Id, entry.Tx.Amount, entry.Tx.BridgeFee)
+		}
+	}
+
+	unbatched := make(map[uint64]bool, len(s.UnbatchedTxIds))
+	for _, id := range s.UnbatchedTxIds {
+		if !pool[id] {
+			return sdkerrors.Wrapf(ErrUnknown, "unbatched tx %d", id)
+		}
+		if unbatched[id] {
+			return sdkerrors.Wrapf(ErrDuplicate, "unbatched tx %d", id)
+		}
+		unbatched[id] = true
+	}
+
+	// transfers that left the pool keep their record, a transfer is either pending or finished
+	records := make(map[uint64]bool, len(s.TransferRecords))
+	for _, record := range s.TransferRecords {
+		if record.TxId == 0 || record.TxId > s.LastTxPoolId {
+			return sdkerrors.Wrapf(ErrInvalid, "transfer record %d, last tx id is %d", record.TxId, s.LastTxPoolId)
+		}
+		if record.State != TRANSFER_STATE_EXECUTED {
+			return sdkerrors.Wrapf(ErrInvalid, "transfer record %d state %s", record.TxId, record.State)
+		}
+		if records[record.TxId] {
+			return sdkerrors.Wrapf(ErrDuplicate, "transfer record %d", record.TxId)
+		}
+		if pool[record.TxId] {
+			return sdkerrors.Wrapf(ErrInvalid, "transfer record %d of a tx in the pool", record.TxId)
+		}
+		records[record.TxId] = true
+	}
+	return nil
+}
+
+func (s GenesisState) validateBatches() error {
+	pool := make(map[uint64]bool, len(s.OutgoingPool))
+	for _, entry := range s.OutgoingPool {
+		pool[entry.Id] = true
+	}
+	for _, id := range s.UnbatchedTxIds {
+		delete(pool, id)
+	}
+
+	batches := make(map[string]bool, len(s.Batches))
+	for _, batch := range s.Batches {
+		if batch == nil {
+			return sdkerrors.Wrap(ErrEmpty, "batch")
+		}
+		if err := ValidateEthAddress(batch.TokenContract); err != nil {
+			return sdkerrors.Wrapf(err, "batch %d token contract", batch.BatchNonce)
+		}
+		if batch.BatchNonce == 0 || batch.BatchNonce > s.LastOutgoingBatchId {
+			return sdkerrors.Wrapf(ErrInvalid, "batch nonce %d, last batch id is %d", batch.BatchNonce, s.LastOutgoingBatchId)
+		}
+		key := fmt.Sprintf("%s/%d", batch.TokenContract, batch.BatchNonce)
+		if batches[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "batch %d of %s", batch.BatchNonce, batch.TokenContract)
+		}
+		batches[key] = true
+		for _, tx := range batch.Transactions {
+			if tx == nil || tx.Erc20Token == nil || tx.Erc20Fee == nil {
+				return sdkerrors.Wrapf(ErrEmpty, "batch %d transaction", batch.BatchNonce)
+			}
+			// every batched tx is in the pool and in no other batch or the fee index
+			if !pool[tx.Id] {
+				return sdkerrors.Wrapf(ErrInvalid, "batch %d tx %d is not in the pool or also unbatched or in another batch", batch.BatchNonce, tx.Id)
+			}
+			delete(pool, tx.Id)
+			if tx.Erc20Token.Contract != batch.TokenContract || tx.Erc20Fee.Contract != batch.TokenContract {
+				return sdkerrors.Wrapf(ErrInvalid, "batch %d tx %d token contract", batch.BatchNonce, tx.Id)
+			}
+		}
+	}
+	for id := range pool {
+		return sdkerrors.Wrapf(ErrInvalid, "tx %d is neither unbatched nor in a batch", id)
+	}
+
+	confirms := make(map[string]bool, len(s.BatchConfirms))
+	for _, conf := range s.BatchConfirms {
+		if err := conf.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "batch %d confirm", conf.Nonce)
+		}
+		if err := validateSignature(conf.Signature); err != nil {
+			return sdkerrors.Wrapf(err, "batch %d confirm", conf.Nonce)
+		}
+		key := fmt.Sprintf("%s/%d", conf.TokenContract, conf.Nonce)
+		if !batches[key] {
+			return sdkerrors.Wrapf(ErrUnknown, "batch %d of %s of confirm by %s", conf.Nonce, conf.TokenContract, conf.Orchestrator)
+		}
+		key += "/" + conf.Orchestrator
+		if confirms[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "batch %d confirm by %s", conf.Nonce, conf.Orchestrator)
+		}
+		confirms[key] = true
+	}
+	return nil
+}
+
+func (s GenesisState) validateLogicCalls() error {
+	nonces := make(map[string]uint64, len(s.LogicCallNonces))
+	for _, n := range s.LogicCallNonces {
+		if n.Module == "" {
+			return sdkerrors.Wrap(ErrEmpty, "logic call nonce module")
+		}
+		if _, ok := nonces[n.Module]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "logic call nonce of %s", n.Module)
+		}
+		nonces[n.Module] = n.Nonce
+	}
+
+	calls := make(map[string]bool, len(s.LogicCalls))
+	for _, call := range s.LogicCalls {
+		if call == nil {
+			return sdkerrors.Wrap(ErrEmpty, "logic call")
+		}
+		key := fmt.Sprintf("%x/%d", call.InvalidationId, call.InvalidationNonce)
+		if err := ValidateEthAddress(call.LogicContractAddress); err != nil {
+			return sdkerrors.Wrapf(err, "logic call %s contract", key)
+		}
+		for _, tokens := range [][]*ERC20Token{call.Transfers, call.Fees} {
+			for _, token := range tokens {
+				if token == nil {
+					return sdkerrors.Wrapf(ErrEmpty, "logic call %s token", key)
+				}
+				if err := token.ValidateBasic(); err != nil {
+					return sdkerrors.Wrapf(err, "logic call %s token", key)
+				}
+			}
+		}
+		if calls[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "logic call %s", key)
+		}
+		calls[key] = true
+		if call.SourceModule != "" && call.InvalidationNonce > nonces[call.SourceModule] {
+			return sdkerrors.Wrapf(ErrInvalid, "logic call %s is ahead of the last logic call nonce of %s", key, call.SourceModule)
+		}
+	}
+
+	confirms := make(map[string]bool, len(s.LogicCallConfirms))
+	for _, conf := range s.LogicCallConfirms {
+		key := fmt.Sprintf("%x/%d", conf.InvalidationId, conf.InvalidationNonce)
+		if err := conf.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "logic call %s confirm", key)
+		}
+		if err := validateSignature(conf.Signature); err != nil {
+			return sdkerrors.Wrapf(err, "logic call %s confirm", key)
+		}
+		if !calls[key] {
+			return sdkerrors.Wrapf(ErrUnknown, "logic call %s of confirm by %s", key, conf.Orchestrator)
+		}
+		key += "/" + conf.Orchestrator
+		if confirms[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "logic call confirm %s", key)
+		}
+		confirms[key] = true
+	}
+	return nil
+}
+
+func (s GenesisState) validateAttestations() error {
+	attestations := make(map[string]bool, len(s.Attestations))
+	for i, att := range s.Attestations {
+		if att.Claim == nil {
+			return sdkerrors.Wrapf(ErrEmpty, "attestation %d claim", i)
+		}
+		claim, ok := att.Claim.GetCachedValue().(EthereumClaim)
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "attestation %d claim", i)
+		}
+		if err := claim.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "attestation %d claim", claim.GetEventNonce())
+		}
+		if att.Observed && claim.GetEventNonce() > s.LastObservedNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "observed attestation %d is above the last observed nonce %d", claim.GetEventNonce(), s.LastObservedNonce)
+		}
+		key := string(GetAttestationKey(claim.GetEventNonce(), claim.ClaimHash()))
+		if attestations[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "attestation %d", claim.GetEventNonce())
+		}
+		attestations[key] = true
+		for _, vote := range att.Votes {
+			if _, err := sdk.ValAddressFromBech32(vote); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "attestation %d vote %s", claim.GetEventNonce(), vote)
+			}
+		}
+	}
+
+	// deposits are credited once their attestation is observed
+	receipts := make(map[uint64]bool, len(s.DepositReceipts))
+	for _, receipt := range s.DepositReceipts {
+		if receipt.EventNonce == 0 || receipt.EventNonce > s.LastObservedNonce {
+			return sdkerrors.Wrapf(ErrInvalid, "deposit receipt %d, last observed nonce is %d", receipt.EventNonce, s.LastObservedNonce)
+		}
+		if receipts[receipt.EventNonce] {
+			return sdkerrors.Wrapf(ErrDuplicate, "deposit receipt %d", receipt.EventNonce)
+		}
+		receipts[receipt.EventNonce] = true
+		if _, err := sdk.AccAddressFromBech32(receipt.CosmosReceiver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "deposit receipt %d receiver %s", receipt.EventNonce, receipt.CosmosReceiver)
+		}
+		if err := ValidateEthAddress(receipt.EthereumSender); err != nil {
+			return sdkerrors.Wrapf(err, "deposit receipt %d sender", receipt.EventNonce)
+		}
+		if err := ValidateEthAddress(receipt.TokenContract); err != nil {
+			return sdkerrors.Wrapf(err, "deposit receipt %d token contract", receipt.EventNonce)
+		}
+	}
+
+	sweepNonces := make(map[string]bool, len(s.FallbackSweepNonces))
+	for _, n := range s.FallbackSweepNonces {
+		if err := ValidateEthAddress(n.EthereumSender); err != nil {
+			return sdkerrors.Wrap(err, "fallback sweep nonce sender")
+		}
+		sender := NormalizeEthAddress(n.EthereumSender)
+		if sweepNonces[sender] {
+			return sdkerrors.Wrapf(ErrDuplicate, "fallback sweep nonce of %s", sender)
+		}
+		sweepNonces[sender] = true
+	}
+	return nil
+}
+
+func (s GenesisState) validateDelegateKeys() error {
+	inUse := make(map[string]string)
+	use := func(key, val string) error {
+		if other, ok := inUse[key]; ok && other != val {
+			return sdkerrors.Wrapf(ErrDuplicate, "%s in use by %s and %s", key, other, val)
+		}
+		inUse[key] = val
+		return nil
+	}
+
+	validators := make(map[string]bool, len(s.DelegateKeys))
+	for _, keys := range s.DelegateKeys {
+		if keys == nil {
+			return sdkerrors.Wrap(ErrEmpty, "delegate keys")
+		}
+		if err := keys.ValidateKeys(); err != nil {
+			return err
+		}
+		if validators[keys.Validator] {
+			return sdkerrors.Wrapf(ErrDuplicate, "delegate keys of %s", keys.Validator)
+		}
+		validators[keys.Validator] = true
+		if err := use(keys.Orchestrator, keys.Validator); err != nil {
+			return err
+		}
+		if err := use(keys.EthAddress, keys.Validator); err != nil {
+			return err
+		}
+	}
+
+	nonces := make(map[string]bool, len(s.DelegateKeyNonces))
+	for _, n := range s.DelegateKeyNonces {
+		if _, err := sdk.ValAddressFromBech32(n.Validator); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, n.Validator)
+		}
+		if nonces[n.Validator] {
+			return sdkerrors.Wrapf(ErrDuplicate, "delegate key nonce of %s", n.Validator)
+		}
+		nonces[n.Validator] = true
+	}
+
+	// pending and previous keys stay reserved for their validator as well
+	for _, rotations := range [][]DelegateKeyRotation{s.PendingDelegateKeyRotations, s.PreviousDelegateKeys} {
+		seen := make(map[string]bool, len(rotations))
+		for _, keys := range rotations {
+			if _, err := sdk.ValAddressFromBech32(keys.Validator); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, keys.Validator)
+			}
+			if seen[keys.Validator] {
+				return sdkerrors.Wrapf(ErrDuplicate, "delegate key rotation of %s", keys.Validator)
+			}
+			seen[keys.Validator] = true
+			if keys.Orchestrator != "" {
+				if _, err := sdk.AccAddressFromBech32(keys.Orchestrator); err != nil {
+					return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, keys.Orchestrator)
+				}
+				if err := use(keys.Orchestrator, keys.Validator); err != nil {
+					return err
+				}
+			}
+			if keys.EthAddress != "" {
+				if err := ValidateEthAddress(keys.EthAddress); err != nil {
+					return sdkerrors.Wrapf(err, "delegate key rotation of %s", keys.Validator)
+				}
+				if err := use(keys.EthAddress, keys.Validator); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s GenesisState) validateValidatorRecords() error {
+	unbonding := make(map[string]bool, len(s.UnbondingValidators))
+	for _, uv := range s.UnbondingValidators {
+		if _, err := sdk.ValAddressFromBech32(uv.Validator); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, uv.Validator)
+		}
+		if unbonding[uv.Validator] {
+			return sdkerrors.Wrapf(ErrDuplicate, "unbonding validator %s", uv.Validator)
+		}
+		unbonding[uv.Validator] = true
+	}
+	return nil
+}
+
+func (s GenesisState) validateTokens() error {
+	erc20s := make(map[string]bool, len(s.Erc20ToDenoms))
+	denoms := make(map[string]bool, len(s.Erc20ToDenoms))
+	for _, m := range s.Erc20ToDenoms {
+		if err := ValidateEthAddress(m.Erc20); err != nil {
+			return sdkerrors.Wrapf(err, "erc20 of %s", m.Denom)
+		}
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return sdkerrors.Wrap(err, "denom")
+		}
+		if _, err := PeggyDenomToERC20(m.Denom); err == nil {
+			return sdkerrors.Wrapf(ErrInvalid, "denom %s is a peggy voucher of an Ethereum originated token", m.Denom)
+		}
+		if erc20s[m.Erc20] || denoms[m.Denom] {
+			return sdkerrors.Wrapf(ErrDuplicate, "mapping of %s to %s", m.Erc20, m.Denom)
+		}
+		erc20s[m.Erc20] = true
+		denoms[m.Denom] = true
+	}
+
+	// unbatched txs need the ERC20 of their denom to be indexed by fee
+	pool := make(map[uint64]OutgoingTx, len(s.OutgoingPool))
+	for _, entry := range s.OutgoingPool {
+		pool[entry.Id] = entry.Tx
+	}
+	for _, id := range s.UnbatchedTxIds {
+		denom := pool[id].BridgeFee.Denom
+		if _, err := PeggyDenomToERC20(denom); err != nil && !denoms[denom] {
+			return sdkerrors.Wrapf(ErrUnknown, "erc20 of denom %s of unbatched tx %d", denom, id)
+		}
+	}
+
+	requests := make(map[string]bool, len(s.Erc20DeploymentRequests))
+	for _, req := range s.Erc20DeploymentRequests {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return sdkerrors.Wrap(err, "erc20 deployment request denom")
+		}
+		if requests[req.Denom] {
+			return sdkerrors.Wrapf(ErrDuplicate, "erc20 deployment request for %s", req.Denom)
+		}
+		requests[req.Denom] = true
+	}
+
+	// validators have one vote for the metadata of a token contract
+	metadata := make(map[string]bool, len(s.Erc20MetadataAttestations))
+	metadataVotes := make(map[string]bool)
+	for _, att := range s.Erc20MetadataAttestations {
+		if err := ValidateEthAddress(att.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "erc20 metadata attestation token contract")
+		}
+		key := string(GetERC20MetadataAttestationKey(att.TokenContract, att.ClaimHash()))
+		if metadata[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "erc20 metadata attestation for %s", att.TokenContract)
+		}
+		metadata[key] = true
+		for _, vote := range att.Votes {
+			if _, err := sdk.ValAddressFromBech32(vote); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "erc20 metadata attestation vote %s", vote)
+			}
+			if metadataVotes[att.TokenContract+"/"+vote] {
+				return sdkerrors.Wrapf(ErrDuplicate, "erc20 metadata vote of %s for %s", vote, att.TokenContract)
+			}
+			metadataVotes[att.TokenContract+"/"+vote] = true
+		}
+	}
 	return nil
 }
 
@@ -91,17 +588,21 @@ func DefaultGenesisState() *GenesisState {
 // DefaultParams returns a copy of the default params
 func DefaultParams() *Params {
 	return &Params{
-		PeggyId:                       "defaultpeggyid",
-		SignedValsetsWindow:           10000,
-		SignedBatchesWindow:           10000,
-		SignedClaimsWindow:            10000,
-		TargetBatchTimeout:            43200000,
-		AverageBlockTime:              5000,
-		AverageEthereumBlockTime:      15000,
-		SlashFractionValset:           sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionBatch:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionClaim:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
-		SlashFractionConflictingClaim: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		PeggyId:                        "defaultpeggyid",
+		SignedValsetsWindow:            10000,
+		SignedBatchesWindow:            10000,
+		SignedClaimsWindow:             10000,
+		TargetBatchTimeout:             43200000,
+		AverageBlockTime:               5000,
+		AverageEthereumBlockTime:       15000,
+		SlashFractionValset:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionBatch:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionClaim:             sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionConflictingClaim:  sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		DelegateKeyRotationGracePeriod: 1000,
+		IbcForwardTimeout:              600000,
+		DepositReceiptRetention:        100000,
+		TransferRecordRetention:        100000,
 	}
 }
 
@@ -149,6 +650,18 @@ func (p Params) ValidateBasic() error {
 	if err := validateSlashFractionConflictingClaim(p.SlashFractionConflictingClaim); err != nil {
 		return sdkerrors.Wrap(err, "slash fraction valset")
 	}
+	if err := validateDelegateKeyRotationGracePeriod(p.DelegateKeyRotationGracePeriod); err != nil {
+		return sdkerrors.Wrap(err, "delegate key rotation grace period")
+	}
+	if err := validateIBCForwardTimeout(p.IbcForwardTimeout); err != nil {
+		return sdkerrors.Wrap(err, "ibc forward timeout")
+	}
+	if err := validateDepositReceiptRetention(p.DepositReceiptRetention); err != nil {
+		return sdkerrors.Wrap(err, "deposit receipt retention")
+	}
+	if err := validateTransferRecordRetention(p.TransferRecordRetention); err != nil {
+		return sdkerrors.Wrap(err, "transfer record retention")
+	}
 	return nil
 }
 
@@ -175,6 +688,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionBatch, &p.SlashFractionBatch, validateSlashFractionBatch),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionClaim, &p.SlashFractionClaim, validateSlashFractionClaim),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
+		paramtypes.NewParamSetPair(ParamsStoreDelegateKeyRotationGracePeriod, &p.DelegateKeyRotationGracePeriod, validateDelegateKeyRotationGracePeriod),
+		paramtypes.NewParamSetPair(ParamsStoreIBCForwardTimeout, &p.IbcForwardTimeout, validateIBCForwardTimeout),
+		paramtypes.NewParamSetPair(ParamsStoreDepositReceiptRetention, &p.DepositReceiptRetention, validateDepositReceiptRetention),
+		paramtypes.NewParamSetPair(ParamsStoreTransferRecordRetention, &p.TransferRecordRetention, validateTransferRecordRetention),
 	}
 }
 
@@ -320,6 +837,46 @@ func validateSlashFractionConflictingClaim(i interface{}) error {
 	return nil
 }
 
+func validateDelegateKeyRotationGracePeriod(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid delegate key rotation grace period, must be at least one block")
+	}
+	return nil
+}
+
+func validateIBCForwardTimeout(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid ibc forward timeout, must be positive")
+	}
+	return nil
+}
+
+func validateDepositReceiptRetention(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid deposit receipt retention, must be at least one block")
+	}
+	return nil
+}
+
+func validateTransferRecordRetention(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val == 0 {
+		return fmt.Errorf("invalid transfer record retention, must be at least one block")
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -84,21 +84,45 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // The slashing fractions for the various peggy related slashing conditions. The first three
 // refer to not submitting a particular message, the third for submitting a different claim
 // for the same Ethereum event
+//
+// delegate_key_rotation_grace_period
+//
+// The time in blocks between a validator requesting a delegate key rotation and the new
+// keys taking effect, this gives orchestrators time to switch over to the new keys
+//
+// ibc_forward_timeout
+//
+// The time in milliseconds after which an ICS-20 transfer forwarding an Ethereum deposit
+// to another chain times out, the deposit is then refunded to the local account of the
+// Ethereum sender
+//
+// deposit_receipt_retention
+//
+// # The time in blocks deposit receipts are kept for after the deposit was credited
+//
+// transfer_record_retention
+//
+// The time in blocks the records of executed transfers are kept for after the execution
+// was observed
 type Params struct {
-	PeggyId                       string                                 `protobuf:"bytes,1,opt,name=peggy_id,json=peggyId,proto3" json:"peggy_id,omitempty"`
-	ContractSourceHash            string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress         string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId                 uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedValsetsWindow           uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
-	SignedBatchesWindow           uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	SignedClaimsWindow            uint64                                 `protobuf:"varint,8,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
-	TargetBatchTimeout            uint64                                 `protobuf:"varint,10,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
-	AverageBlockTime              uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime      uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	SlashFractionValset           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
-	SlashFractionBatch            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionClaim            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
-	SlashFractionConflictingClaim github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	PeggyId                        string                                 `protobuf:"bytes,1,opt,name=peggy_id,json=peggyId,proto3" json:"peggy_id,omitempty"`
+	ContractSourceHash             string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress          string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                  uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedValsetsWindow            uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow            uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedClaimsWindow             uint64                                 `protobuf:"varint,8,opt,name=signed_claims_window,json=signedClaimsWindow,proto3" json:"signed_claims_window,omitempty"`
+	TargetBatchTimeout             uint64                                 `protobuf:"varint,10,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
+	AverageBlockTime               uint64                                 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime       uint64                                 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	SlashFractionValset            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionClaim             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
+	SlashFractionConflictingClaim  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	DelegateKeyRotationGracePeriod uint64                                 `protobuf:"varint,17,opt,name=delegate_key_rotation_grace_period,json=delegateKeyRotationGracePeriod,proto3" json:"delegate_key_rotation_grace_period,omitempty"`
+	IbcForwardTimeout              uint64                                 `protobuf:"varint,18,opt,name=ibc_forward_timeout,json=ibcForwardTimeout,proto3" json:"ibc_forward_timeout,omitempty"`
+	DepositReceiptRetention        uint64                                 `protobuf:"varint,19,opt,name=deposit_receipt_retention,json=depositReceiptRetention,proto3" json:"deposit_receipt_retention,omitempty"`
+	TransferRecordRetention        uint64                                 `protobuf:"varint,20,opt,name=transfer_record_retention,json=transferRecordRetention,proto3" json:"transfer_record_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -204,6 +228,34 @@ func (m *Params) GetAverageEthereumBlockTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetDelegateKeyRotationGracePeriod() uint64 {
+	if m != nil {
+		return m.DelegateKeyRotationGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetIbcForwardTimeout() uint64 {
+	if m != nil {
+		return m.IbcForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetDepositReceiptRetention() uint64 {
+	if m != nil {
+		return m.DepositReceiptRetention
+	}
+	return 0
+}
+
+func (m *Params) GetTransferRecordRetention() uint64 {
+	if m != nil {
+		return m.TransferRecordRetention
+	}
+	return 0
+}
+
 // GenesisState struct
 type GenesisState struct {
 	Params            *Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
@@ -216,6 +268,26 @@ type GenesisState struct {
 	LogicCallConfirms []MsgConfirmLogicCall        `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
 	Attestations      []Attestation                `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
 	DelegateKeys      []*MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	// outgoing_pool holds all transactions in the outgoing pool, batched or not,
+	// unbatched_tx_ids the ids of those available for batching in the order
+	// transactions with equal fees are picked for a batch
+	OutgoingPool                []OutgoingPoolEntry             `protobuf:"bytes,11,rep,name=outgoing_pool,json=outgoingPool,proto3" json:"outgoing_pool"`
+	UnbatchedTxIds              []uint64                        `protobuf:"varint,12,rep,packed,name=unbatched_tx_ids,json=unbatchedTxIds,proto3" json:"unbatched_tx_ids,omitempty"`
+	LastTxPoolId                uint64                          `protobuf:"varint,13,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
+	LastOutgoingBatchId         uint64                          `protobuf:"varint,14,opt,name=last_outgoing_batch_id,json=lastOutgoingBatchId,proto3" json:"last_outgoing_batch_id,omitempty"`
+	Erc20ToDenoms               []ERC20ToDenom                  `protobuf:"bytes,15,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	LastObservedEthereumHeight  LastObservedEthereumBlockHeight `protobuf:"bytes,16,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	DelegateKeyNonces           []DelegateKeyNonce              `protobuf:"bytes,17,rep,name=delegate_key_nonces,json=delegateKeyNonces,proto3" json:"delegate_key_nonces"`
+	PendingDelegateKeyRotations []DelegateKeyRotation           `protobuf:"bytes,18,rep,name=pending_delegate_key_rotations,json=pendingDelegateKeyRotations,proto3" json:"pending_delegate_key_rotations"`
+	PreviousDelegateKeys        []DelegateKeyRotation           `protobuf:"bytes,19,rep,name=previous_delegate_keys,json=previousDelegateKeys,proto3" json:"previous_delegate_keys"`
+	UnbondingValidators         []UnbondingValidator            `protobuf:"bytes,20,rep,name=unbonding_validators,json=unbondingValidators,proto3" json:"unbonding_validators"`
+	LastSlashedLogicCallBlock   uint64                          `protobuf:"varint,21,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	LogicCallNonces             []LogicCallNonce                `protobuf:"bytes,22,rep,name=logic_call_nonces,json=logicCallNonces,proto3" json:"logic_call_nonces"`
+	Erc20DeploymentRequests     []ERC20DeploymentRequest        `protobuf:"bytes,23,rep,name=erc20_deployment_requests,json=erc20DeploymentRequests,proto3" json:"erc20_deployment_requests"`
+	TransferRecords             []TransferRecord                `protobuf:"bytes,24,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	DepositReceipts             []DepositReceipt                `protobuf:"bytes,25,rep,name=deposit_receipts,json=depositReceipts,proto3" json:"deposit_receipts"`
+	FallbackSweepNonces         []FallbackSweepNonce            `protobuf:"bytes,26,rep,name=fallback_sweep_nonces,json=fallbackSweepNonces,proto3" json:"fallback_sweep_nonces"`
+	Erc20MetadataAttestations   []ERC20MetadataAttestation      `protobuf:"bytes,27,rep,name=erc20_metadata_attestations,json=erc20MetadataAttestations,proto3" json:"erc20_metadata_attestations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -321,474 +393,2023 @@ func (m *GenesisState) GetDelegateKeys() []*MsgSetOrchestratorAddress {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "peggy.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "peggy.v1.GenesisState")
+func (m *GenesisState) GetOutgoingPool() []OutgoingPoolEntry {
+	if m != nil {
+		return m.OutgoingPool
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("peggy/v1/genesis.proto", fileDescriptor_84231c3b3f050761) }
+func (m *GenesisState) GetUnbatchedTxIds() []uint64 {
+	if m != nil {
+		return m.UnbatchedTxIds
+	}
+	return nil
+}
 
-var fileDescriptor_84231c3b3f050761 = []byte{
-	// 825 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x13, 0x9a, 0x26, 0xdb, 0xd9, 0x64, 0x77, 0x3b, 0x49, 0xc0, 0x4d, 0xd5, 0x34, 0x2a,
-	0x52, 0x15, 0x21, 0x9a, 0x6c, 0x5b, 0x89, 0x03, 0x02, 0xa1, 0x26, 0x50, 0x5a, 0xa0, 0x2c, 0x72,
-	0x2a, 0x90, 0xb8, 0x98, 0x89, 0xfd, 0x3a, 0x1e, 0xad, 0xed, 0x89, 0x3c, 0x93, 0x6c, 0x73, 0xe3,
-	0x23, 0x70, 0xe1, 0x3b, 0xf5, 0xd8, 0x23, 0x42, 0xa8, 0x42, 0xbb, 0x5f, 0x81, 0x0f, 0x80, 0xfc,
-	0x66, 0x6c, 0x27, 0xbb, 0x7b, 0x5a, 0xf5, 0x14, 0xfb, 0xfd, 0xdf, 0xef, 0xfd, 0x9f, 0xde, 0xf8,
-	0x4d, 0xc8, 0x87, 0x0b, 0xe0, 0x7c, 0x3d, 0x5e, 0x3d, 0x1c, 0x73, 0x48, 0x40, 0x09, 0x35, 0x5a,
-	0xa4, 0x52, 0x4b, 0xba, 0x83, 0xf1, 0xd1, 0xea, 0x61, 0xaf, 0xc3, 0x25, 0x97, 0x18, 0x1c, 0x67,
-	0x4f, 0x46, 0xef, 0x75, 0x0a, 0x4e, 0xaf, 0x17, 0x60, 0xa9, 0x5e, 0xbb, 0x88, 0xc6, 0x8a, 0xab,
-	0x0b, 0xa9, 0x73, 0xa6, 0xfd, 0xd0, 0x46, 0x7b, 0x45, 0x94, 0x69, 0x0d, 0x4a, 0x33, 0x2d, 0x64,
-	0x62, 0xb4, 0x7b, 0x7f, 0x36, 0x48, 0xfd, 0x27, 0x96, 0xb2, 0x58, 0xd1, 0x5b, 0xc4, 0x74, 0xe2,
-	0x89, 0xc0, 0xa9, 0x0e, 0xaa, 0xc3, 0x1b, 0x6e, 0x03, 0xdf, 0x9f, 0x07, 0xf4, 0x90, 0x74, 0x7c,
-	0x99, 0xe8, 0x94, 0xf9, 0xda, 0x53, 0x72, 0x99, 0xfa, 0xe0, 0x85, 0x4c, 0x85, 0xce, 0x07, 0x98,
-	0x46, 0x73, 0x6d, 0x86, 0xd2, 0x33, 0xa6, 0x42, 0xfa, 0x19, 0xf9, 0x68, 0x9e, 0x8a, 0x80, 0x83,
-	0x07, 0x3a, 0x84, 0x14, 0x96, 0xb1, 0xc7, 0x82, 0x20, 0x05, 0xa5, 0x9c, 0x1a, 0x42, 0x5d, 0x23,
-	0x7f, 0x63, 0xd5, 0x27, 0x46, 0xa4, 0xf7, 0xc9, 0xbe, 0xe5, 0xfc, 0x90, 0x89, 0x24, 0xeb, 0xe5,
-	0xfa, 0xa0, 0x3a, 0xac, 0xb9, 0x2d, 0x13, 0x9e, 0x66, 0xd1, 0xe7, 0x01, 0x7d, 0x44, 0xba, 0x4a,
-	0xf0, 0x04, 0x02, 0x6f, 0xc5, 0x22, 0x05, 0x5a, 0x79, 0x27, 0x22, 0x09, 0xe4, 0x89, 0x53, 0xc7,
-	0xec, 0xb6, 0x11, 0x7f, 0x36, 0xda, 0x2f, 0x28, 0x6d, 0x30, 0x38, 0x1d, 0x28, 0x98, 0xc6, 0x26,
-	0x33, 0x31, 0x9a, 0x65, 0x0e, 0x49, 0xc7, 0x32, 0x7e, 0xc4, 0x44, 0x5c, 0x20, 0x3b, 0x88, 0x50,
-	0xa3, 0x4d, 0x51, 0x2a, 0x09, 0xcd, 0x52, 0x0e, 0xda, 0xb8, 0x78, 0x5a, 0xc4, 0x20, 0x97, 0xda,
-	0x21, 0x86, 0x30, 0x1a, 0x9a, 0xbc, 0x34, 0x0a, 0xfd, 0x94, 0x50, 0xb6, 0x82, 0x94, 0x71, 0xf0,
-	0xe6, 0x91, 0xf4, 0x8f, 0x11, 0x71, 0x76, 0x31, 0xff, 0xc0, 0x2a, 0x93, 0x4c, 0xc8, 0x00, 0xfa,
-	0x25, 0xb9, 0x9d, 0x67, 0x17, 0xa3, 0xdd, 0xc0, 0x9a, 0x88, 0x39, 0x36, 0x25, 0x1f, 0x6f, 0x89,
-	0xcf, 0x49, 0x57, 0x45, 0x4c, 0x85, 0xde, 0xab, 0xec, 0xc4, 0x84, 0x4c, 0xec, 0x00, 0x9d, 0xd6,
-	0xa0, 0x3a, 0x6c, 0x4e, 0x46, 0x6f, 0xde, 0xdd, 0xad, 0xfc, 0xfd, 0xee, 0xee, 0x7d, 0x2e, 0x74,
-	0xb8, 0x9c, 0x8f, 0x7c, 0x19, 0x8f, 0x7d, 0xa9, 0x62, 0xa9, 0xec, 0xcf, 0x03, 0x15, 0x1c, 0xdb,
-	0x0f, 0xf1, 0x6b, 0xf0, 0xdd, 0x36, 0x16, 0x7b, 0x6a, 0x6b, 0x99, 0x79, 0xd3, 0xdf, 0x48, 0xe7,
-	0x9c, 0x07, 0x8e, 0xc2, 0xd9, 0xbb, 0x92, 0x05, 0xdd, 0xb2, 0xc0, 0xc9, 0x5d, 0xe2, 0x80, 0xc7,
-	0xe3, 0xec, 0xbf, 0x07, 0x07, 0x3c, 0x4d, 0x7a, 0x42, 0x06, 0xe7, 0x1d, 0x64, 0xf2, 0x2a, 0x12,
-	0xbe, 0x16, 0x09, 0xb7, 0x6e, 0x07, 0x57, 0x72, 0xbb, 0xb3, 0xed, 0x56, 0x56, 0x45, 0xe3, 0xcf,
-	0x6b, 0xbf, 0xff, 0x33, 0xa8, 0xdc, 0xfb, 0xaf, 0x46, 0x9a, 0xdf, 0x9a, 0x6b, 0x62, 0xa6, 0x99,
-	0x06, 0x3a, 0x24, 0xf5, 0x05, 0xee, 0x29, 0xee, 0xe6, 0xee, 0xa3, 0x83, 0x51, 0x7e, 0x6d, 0x8c,
-	0xcc, 0xfe, 0xba, 0x56, 0xa7, 0x23, 0xd2, 0x8e, 0x98, 0xd2, 0x9e, 0x9c, 0x2b, 0x48, 0x57, 0x10,
-	0x78, 0x89, 0x4c, 0x7c, 0xc0, 0x5d, 0xad, 0xb9, 0x37, 0x33, 0xe9, 0xc8, 0x2a, 0x3f, 0x66, 0x02,
-	0xfd, 0x84, 0x34, 0xec, 0x0e, 0x39, 0xd7, 0x06, 0xd7, 0xb6, 0x4b, 0x9b, 0x03, 0x75, 0xf3, 0x04,
-	0x3a, 0x25, 0xfb, 0xe6, 0x11, 0xa7, 0x21, 0xd2, 0x38, 0x5b, 0xe7, 0x8c, 0xe9, 0x95, 0xcc, 0x0b,
-	0xc5, 0x0d, 0x36, 0x35, 0x29, 0xee, 0xde, 0x6a, 0xf3, 0x55, 0xd1, 0xc7, 0xa4, 0x61, 0x17, 0xd0,
-	0xb9, 0x8e, 0xf0, 0xad, 0x12, 0x3e, 0x5a, 0x6a, 0x2e, 0x45, 0xc2, 0x5f, 0xbe, 0xc6, 0x83, 0x76,
-	0xf3, 0x4c, 0xfa, 0x94, 0xec, 0x99, 0x7d, 0x2a, 0x8c, 0xeb, 0xe7, 0xd9, 0x17, 0x8a, 0x5b, 0x0f,
-	0x64, 0x27, 0xb5, 0xec, 0x60, 0xdc, 0x16, 0x62, 0x85, 0xf9, 0x17, 0x64, 0x37, 0x92, 0x5c, 0xf8,
-	0x9e, 0xcf, 0xa2, 0x48, 0x39, 0x0d, 0x2c, 0x72, 0xfb, 0x62, 0x03, 0x3f, 0x64, 0x49, 0x53, 0x16,
-	0x45, 0x2e, 0x89, 0xf2, 0x47, 0x45, 0x67, 0xa4, 0x5d, 0xd2, 0x65, 0x2b, 0x3b, 0x58, 0xe5, 0xce,
-	0x65, 0xad, 0x14, 0x75, 0x6c, 0x3b, 0x37, 0x8b, 0x6a, 0x45, 0x4b, 0x5f, 0x91, 0xe6, 0xc6, 0xc5,
-	0xac, 0x9c, 0x1b, 0x58, 0xad, 0x5b, 0x56, 0x7b, 0x52, 0xaa, 0xb6, 0xca, 0x16, 0x40, 0x9f, 0x91,
-	0x56, 0x00, 0x11, 0x70, 0xa6, 0xc1, 0x3b, 0x86, 0xb5, 0x72, 0x08, 0x56, 0xf8, 0x78, 0xab, 0x9f,
-	0x19, 0xe8, 0xa3, 0x34, 0x1b, 0xa5, 0x4e, 0x99, 0x96, 0xa9, 0xbd, 0x70, 0xdd, 0x66, 0x4e, 0x7e,
-	0x0f, 0x6b, 0x35, 0xf9, 0xee, 0xcd, 0x69, 0xbf, 0xfa, 0xf6, 0xb4, 0x5f, 0xfd, 0xf7, 0xb4, 0x5f,
-	0xfd, 0xe3, 0xac, 0x5f, 0x79, 0x7b, 0xd6, 0xaf, 0xfc, 0x75, 0xd6, 0xaf, 0xfc, 0x7a, 0xb8, 0xf1,
-	0x75, 0xb3, 0x48, 0x87, 0xc0, 0x1e, 0x24, 0xa0, 0xc7, 0xe6, 0xaf, 0x25, 0x96, 0xc1, 0x32, 0x82,
-	0xf1, 0x6b, 0xfb, 0x8a, 0xdf, 0xfa, 0xbc, 0x8e, 0xff, 0x30, 0x8f, 0xff, 0x0f, 0x00, 0x00, 0xff,
-	0xff, 0x01, 0x66, 0xdc, 0xa4, 0xf8, 0x06, 0x00, 0x00,
+func (m *GenesisState) GetLastTxPoolId() uint64 {
+	if m != nil {
+		return m.LastTxPoolId
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetLastOutgoingBatchId() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetErc20ToDenoms() []ERC20ToDenom {
+	if m != nil {
+		return m.Erc20ToDenoms
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SlashFractionConflictingClaim.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+func (m *GenesisState) GetLastObservedEthereumHeight() LastObservedEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.SlashFractionClaim.Size()
-		i -= size
-		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return LastObservedEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetDelegateKeyNonces() []DelegateKeyNonce {
+	if m != nil {
+		return m.DelegateKeyNonces
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetPendingDelegateKeyRotations() []DelegateKeyRotation {
+	if m != nil {
+		return m.PendingDelegateKeyRotations
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetPreviousDelegateKeys() []DelegateKeyRotation {
+	if m != nil {
+		return m.PreviousDelegateKeys
 	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x60
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingValidators() []UnbondingValidator {
+	if m != nil {
+		return m.UnbondingValidators
 	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
+	return nil
+}
+
+func (m *GenesisState) GetLastSlashedLogicCallBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedLogicCallBlock
 	}
-	if m.TargetBatchTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *GenesisState) GetLogicCallNonces() []LogicCallNonce {
+	if m != nil {
+		return m.LogicCallNonces
 	}
-	if m.SignedClaimsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *GenesisState) GetErc20DeploymentRequests() []ERC20DeploymentRequest {
+	if m != nil {
+		return m.Erc20DeploymentRequests
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return nil
+}
+
+func (m *GenesisState) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
 	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *GenesisState) GetDepositReceipts() []DepositReceipt {
+	if m != nil {
+		return m.DepositReceipts
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *GenesisState) GetFallbackSweepNonces() []FallbackSweepNonce {
+	if m != nil {
+		return m.FallbackSweepNonces
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *GenesisState) GetErc20MetadataAttestations() []ERC20MetadataAttestation {
+	if m != nil {
+		return m.Erc20MetadataAttestations
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+// OutgoingPoolEntry is a transaction in the outgoing pool with its id
+type OutgoingPoolEntry struct {
+	Id uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tx OutgoingTx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
+}
+
+func (m *OutgoingPoolEntry) Reset()         { *m = OutgoingPoolEntry{} }
+func (m *OutgoingPoolEntry) String() string { return proto.CompactTextString(m) }
+func (*OutgoingPoolEntry) ProtoMessage()    {}
+func (*OutgoingPoolEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84231c3b3f050761, []int{2}
+}
+func (m *OutgoingPoolEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingPoolEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingPoolEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PeggyId) > 0 {
-		i -= len(m.PeggyId)
-		copy(dAtA[i:], m.PeggyId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PeggyId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OutgoingPoolEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingPoolEntry.Merge(m, src)
+}
+func (m *OutgoingPoolEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingPoolEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingPoolEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingPoolEntry proto.InternalMessageInfo
+
+func (m *OutgoingPoolEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OutgoingPoolEntry) GetTx() OutgoingTx {
+	if m != nil {
+		return m.Tx
 	}
-	return dAtA[:n], nil
+	return OutgoingTx{}
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ERC20ToDenom maps the ERC20 representing a Cosmos originated denom on
+// Ethereum to the denom
+type ERC20ToDenom struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
+func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
+func (*ERC20ToDenom) ProtoMessage()    {}
+func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84231c3b3f050761, []int{3}
+}
+func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+}
+func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+}
+func (m *ERC20ToDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ToDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+
+func (m *ERC20ToDenom) GetErc20() string {
+	if m != nil {
+		return m.Erc20
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return ""
+}
+
+func (m *ERC20ToDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.LogicCalls) > 0 {
-		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	return ""
+}
+
+// DelegateKeyNonce is the delegate key nonce of a validator
+type DelegateKeyNonce struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Nonce     uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *DelegateKeyNonce) Reset()         { *m = DelegateKeyNonce{} }
+func (m *DelegateKeyNonce) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeyNonce) ProtoMessage()    {}
+func (*DelegateKeyNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84231c3b3f050761, []int{4}
+}
+func (m *DelegateKeyNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeyNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeyNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BatchConfirms) > 0 {
-		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+}
+func (m *DelegateKeyNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeyNonce.Merge(m, src)
+}
+func (m *DelegateKeyNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeyNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeyNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeyNonce proto.InternalMessageInfo
+
+func (m *DelegateKeyNonce) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return ""
+}
+
+func (m *DelegateKeyNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// LogicCallNonce is the last invalidation nonce assigned to the logic calls
+// of a module
+type LogicCallNonce struct {
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Nonce  uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *LogicCallNonce) Reset()         { *m = LogicCallNonce{} }
+func (m *LogicCallNonce) String() string { return proto.CompactTextString(m) }
+func (*LogicCallNonce) ProtoMessage()    {}
+func (*LogicCallNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84231c3b3f050761, []int{5}
+}
+func (m *LogicCallNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogicCallNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogicCallNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *LogicCallNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicCallNonce.Merge(m, src)
+}
+func (m *LogicCallNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogicCallNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicCallNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicCallNonce proto.InternalMessageInfo
+
+func (m *LogicCallNonce) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	if m.LastObservedNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *LogicCallNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return 0
+}
+
+// FallbackSweepNonce is the sweep nonce of the fallback account of an
+// Ethereum sender
+type FallbackSweepNonce struct {
+	EthereumSender string `protobuf:"bytes,1,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	Nonce          uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *FallbackSweepNonce) Reset()         { *m = FallbackSweepNonce{} }
+func (m *FallbackSweepNonce) String() string { return proto.CompactTextString(m) }
+func (*FallbackSweepNonce) ProtoMessage()    {}
+func (*FallbackSweepNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84231c3b3f050761, []int{6}
+}
+func (m *FallbackSweepNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FallbackSweepNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FallbackSweepNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FallbackSweepNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FallbackSweepNonce.Merge(m, src)
+}
+func (m *FallbackSweepNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *FallbackSweepNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_FallbackSweepNonce.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_FallbackSweepNonce proto.InternalMessageInfo
+
+func (m *FallbackSweepNonce) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FallbackSweepNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "peggy.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "peggy.v1.GenesisState")
+	proto.RegisterType((*OutgoingPoolEntry)(nil), "peggy.v1.OutgoingPoolEntry")
+	proto.RegisterType((*ERC20ToDenom)(nil), "peggy.v1.ERC20ToDenom")
+	proto.RegisterType((*DelegateKeyNonce)(nil), "peggy.v1.DelegateKeyNonce")
+	proto.RegisterType((*LogicCallNonce)(nil), "peggy.v1.LogicCallNonce")
+	proto.RegisterType((*FallbackSweepNonce)(nil), "peggy.v1.FallbackSweepNonce")
+}
+
+func init() { proto.RegisterFile("peggy/v1/genesis.proto", fileDescriptor_84231c3b3f050761) }
+
+var fileDescriptor_84231c3b3f050761 = []byte{
+	// 1507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5d, 0x4f, 0x1b, 0x47,
+	0x17, 0x06, 0xe2, 0x40, 0x18, 0x0c, 0x86, 0xb1, 0x81, 0x05, 0x12, 0xc7, 0xf2, 0xab, 0x37, 0xa5,
+	0x51, 0x03, 0x84, 0x48, 0xbd, 0x88, 0xfa, 0x15, 0x70, 0x48, 0x48, 0x93, 0x82, 0xd6, 0x24, 0x55,
+	0x7b, 0xb3, 0x1d, 0xef, 0x0c, 0xeb, 0x15, 0xeb, 0x1d, 0x77, 0x67, 0x6c, 0xcc, 0x5d, 0x6f, 0x7b,
+	0xd7, 0x1f, 0xd3, 0x1f, 0x91, 0xcb, 0x5c, 0x56, 0x55, 0x15, 0x55, 0xc9, 0x1f, 0xa9, 0xe6, 0xcc,
+	0xec, 0xa7, 0x1d, 0xa9, 0x8a, 0x7a, 0x65, 0xef, 0x79, 0xce, 0xf3, 0x9c, 0xe3, 0x33, 0x67, 0xce,
+	0xf1, 0xa2, 0xb5, 0x3e, 0xf3, 0xbc, 0xab, 0xdd, 0xe1, 0xfd, 0x5d, 0x8f, 0x85, 0x4c, 0xf8, 0x62,
+	0xa7, 0x1f, 0x71, 0xc9, 0xf1, 0x0d, 0xb0, 0xef, 0x0c, 0xef, 0x6f, 0xd6, 0x3c, 0xee, 0x71, 0x30,
+	0xee, 0xaa, 0x6f, 0x1a, 0xdf, 0xac, 0x25, 0x3c, 0x79, 0xd5, 0x67, 0x86, 0xb5, 0x59, 0x4d, 0xac,
+	0x3d, 0xe1, 0x89, 0x31, 0xd7, 0x0e, 0x91, 0x6e, 0xd7, 0x58, 0x37, 0x13, 0x2b, 0x91, 0x92, 0x09,
+	0x49, 0xa4, 0xcf, 0xc3, 0x31, 0x99, 0x3e, 0xe7, 0x81, 0x36, 0x36, 0x7f, 0x9d, 0x47, 0xb3, 0xa7,
+	0x24, 0x22, 0x3d, 0x81, 0x37, 0x90, 0x4e, 0xcf, 0xf1, 0xa9, 0x35, 0xdd, 0x98, 0xde, 0x9e, 0xb7,
+	0xe7, 0xe0, 0xf9, 0x98, 0xe2, 0x3d, 0x54, 0x73, 0x79, 0x28, 0x23, 0xe2, 0x4a, 0x47, 0xf0, 0x41,
+	0xe4, 0x32, 0xa7, 0x4b, 0x44, 0xd7, 0x9a, 0x01, 0x37, 0x1c, 0x63, 0x6d, 0x80, 0x9e, 0x12, 0xd1,
+	0xc5, 0x9f, 0xa3, 0xf5, 0x4e, 0xe4, 0x53, 0x8f, 0x39, 0x4c, 0x76, 0x59, 0xc4, 0x06, 0x3d, 0x87,
+	0x50, 0x1a, 0x31, 0x21, 0xac, 0x12, 0x90, 0x56, 0x35, 0xfc, 0xd8, 0xa0, 0x8f, 0x34, 0x88, 0xef,
+	0xa0, 0x8a, 0xe1, 0xb9, 0x5d, 0xe2, 0x87, 0x2a, 0x97, 0xeb, 0x8d, 0xe9, 0xed, 0x92, 0xbd, 0xa8,
+	0xcd, 0x87, 0xca, 0x7a, 0x4c, 0xf1, 0x3e, 0x5a, 0x15, 0xbe, 0x17, 0x32, 0xea, 0x0c, 0x49, 0x20,
+	0x98, 0x14, 0xce, 0xa5, 0x1f, 0x52, 0x7e, 0x69, 0xcd, 0x82, 0x77, 0x55, 0x83, 0xaf, 0x34, 0xf6,
+	0x3d, 0x40, 0x19, 0x0e, 0x94, 0x8c, 0x25, 0x9c, 0xb9, 0x2c, 0xe7, 0x40, 0x63, 0x86, 0xb3, 0x87,
+	0x6a, 0x86, 0xe3, 0x06, 0xc4, 0xef, 0x25, 0x94, 0x1b, 0x40, 0xc1, 0x1a, 0x3b, 0x04, 0x28, 0x65,
+	0x48, 0x12, 0x79, 0x4c, 0xea, 0x28, 0x8e, 0xf4, 0x7b, 0x8c, 0x0f, 0xa4, 0x85, 0x34, 0x43, 0x63,
+	0x10, 0xe4, 0x4c, 0x23, 0xf8, 0x33, 0x84, 0xc9, 0x90, 0x45, 0xc4, 0x63, 0x4e, 0x27, 0xe0, 0xee,
+	0x05, 0x50, 0xac, 0x05, 0xf0, 0x5f, 0x36, 0xc8, 0x81, 0x02, 0x14, 0x01, 0x7f, 0x89, 0xb6, 0x62,
+	0xef, 0xa4, 0xb4, 0x19, 0x5a, 0x19, 0x68, 0x96, 0x71, 0x89, 0xcb, 0x9b, 0xd2, 0x3b, 0x68, 0x55,
+	0x04, 0x44, 0x74, 0x9d, 0x73, 0x75, 0x62, 0x3e, 0x0f, 0x4d, 0x01, 0xad, 0xc5, 0xc6, 0xf4, 0x76,
+	0xf9, 0x60, 0xe7, 0xf5, 0xdb, 0xdb, 0x53, 0x7f, 0xbe, 0xbd, 0x7d, 0xc7, 0xf3, 0x65, 0x77, 0xd0,
+	0xd9, 0x71, 0x79, 0x6f, 0xd7, 0xe5, 0xa2, 0xc7, 0x85, 0xf9, 0xb8, 0x27, 0xe8, 0x85, 0xe9, 0xce,
+	0x16, 0x73, 0xed, 0x2a, 0x88, 0x1d, 0x19, 0x2d, 0x5d, 0x6f, 0xfc, 0x13, 0xaa, 0x15, 0x62, 0x40,
+	0x29, 0xac, 0xa5, 0x8f, 0x0a, 0x81, 0x73, 0x21, 0xa0, 0x72, 0x13, 0x22, 0xc0, 0xf1, 0x58, 0x95,
+	0xff, 0x20, 0x02, 0x9c, 0x26, 0xbe, 0x44, 0x8d, 0x62, 0x04, 0x1e, 0x9e, 0x07, 0xbe, 0x2b, 0xfd,
+	0xd0, 0x33, 0xd1, 0x96, 0x3f, 0x2a, 0xda, 0xad, 0x7c, 0xb4, 0x54, 0x55, 0x07, 0x7e, 0x86, 0x9a,
+	0x94, 0x05, 0xcc, 0x23, 0x92, 0x39, 0x17, 0xec, 0xca, 0x89, 0xb8, 0xbe, 0xc5, 0x8e, 0x17, 0x11,
+	0x97, 0x39, 0x7d, 0x16, 0xf9, 0x9c, 0x5a, 0x2b, 0x70, 0xcc, 0xf5, 0xd8, 0xf3, 0x5b, 0x76, 0x65,
+	0x1b, 0xbf, 0x27, 0xca, 0xed, 0x14, 0xbc, 0xf0, 0x0e, 0xaa, 0xfa, 0x1d, 0xd7, 0x39, 0xe7, 0xd1,
+	0x25, 0x89, 0x68, 0xd2, 0x8a, 0x18, 0xc8, 0x2b, 0x7e, 0xc7, 0x3d, 0xd2, 0x48, 0xdc, 0x89, 0x0f,
+	0xd1, 0x06, 0x65, 0x7d, 0x2e, 0x7c, 0xe9, 0x44, 0xcc, 0x65, 0x7e, 0x5f, 0x7d, 0x4a, 0x16, 0x2a,
+	0x5d, 0xab, 0x0a, 0xac, 0x75, 0xe3, 0x60, 0x6b, 0xdc, 0x8e, 0x61, 0xc5, 0x95, 0x11, 0x09, 0xc5,
+	0x39, 0x8b, 0x14, 0x99, 0x47, 0x34, 0xc3, 0xad, 0x69, 0x6e, 0xec, 0x60, 0x03, 0x9e, 0x70, 0x1f,
+	0x96, 0x7e, 0xf9, 0xab, 0x31, 0xd5, 0xfc, 0xbd, 0x82, 0xca, 0x4f, 0xf4, 0xbc, 0x6c, 0x4b, 0x22,
+	0x19, 0xde, 0x46, 0xb3, 0x7d, 0x98, 0x4d, 0x30, 0x8f, 0x16, 0xf6, 0x97, 0x77, 0xe2, 0xf9, 0xb9,
+	0xa3, 0x67, 0x96, 0x6d, 0x70, 0xf5, 0x43, 0x03, 0x22, 0xa4, 0xc3, 0x3b, 0x82, 0x45, 0x43, 0x46,
+	0x9d, 0x90, 0x87, 0x2e, 0x83, 0xf9, 0x54, 0xb2, 0x57, 0x14, 0x74, 0x62, 0x90, 0xef, 0x14, 0x80,
+	0xef, 0xa2, 0x39, 0x33, 0x37, 0xac, 0x6b, 0x8d, 0x6b, 0x79, 0x69, 0xdd, 0xc4, 0x76, 0xec, 0x80,
+	0x0f, 0x51, 0x45, 0x7f, 0x85, 0x0e, 0xf0, 0xa3, 0x9e, 0x1a, 0x61, 0x8a, 0xb3, 0x99, 0x72, 0x5e,
+	0x08, 0x4f, 0xd3, 0x0e, 0xb5, 0x8b, 0xbd, 0x34, 0xcc, 0x3e, 0x0a, 0xfc, 0x00, 0xcd, 0x99, 0xa1,
+	0x63, 0x5d, 0x07, 0xf2, 0x46, 0x4a, 0x3e, 0x19, 0x48, 0x8f, 0xfb, 0xa1, 0x77, 0x36, 0x82, 0xe6,
+	0xb6, 0x63, 0x4f, 0x7c, 0x84, 0x96, 0xe0, 0x6b, 0x1a, 0x78, 0xb6, 0xc8, 0x7d, 0x21, 0x3c, 0x13,
+	0x03, 0xb8, 0x07, 0x25, 0xd5, 0x8c, 0xf6, 0x22, 0xd0, 0x92, 0xe0, 0x5f, 0xa0, 0x85, 0x80, 0x7b,
+	0xbe, 0xeb, 0xb8, 0x24, 0x08, 0x84, 0x35, 0x07, 0x22, 0x5b, 0xe3, 0x09, 0x3c, 0x57, 0x4e, 0x87,
+	0x24, 0x08, 0x6c, 0x14, 0xc4, 0x5f, 0x05, 0x6e, 0xa3, 0x6a, 0xca, 0x4e, 0x53, 0xb9, 0x01, 0x2a,
+	0xb7, 0x26, 0xa5, 0x92, 0xe8, 0x98, 0x74, 0x56, 0x12, 0xb5, 0x24, 0xa5, 0xaf, 0x51, 0x39, 0xb3,
+	0xa1, 0x84, 0x35, 0x0f, 0x6a, 0xab, 0xa9, 0xda, 0xa3, 0x14, 0x35, 0x2a, 0x39, 0x02, 0x7e, 0x8a,
+	0x16, 0xb3, 0xd7, 0x44, 0x58, 0x08, 0x14, 0xfe, 0x97, 0xcb, 0xa7, 0xcd, 0xe4, 0x49, 0xa4, 0x4a,
+	0x29, 0x23, 0x22, 0x79, 0x64, 0x96, 0x8c, 0x5d, 0xce, 0x5c, 0x1b, 0x55, 0xe5, 0x45, 0x6e, 0x0a,
+	0xe0, 0xa8, 0xcd, 0x68, 0x2d, 0x7c, 0xa8, 0x3e, 0xa7, 0x9c, 0x07, 0x8f, 0x43, 0x19, 0x5d, 0xc5,
+	0x19, 0xf1, 0x0c, 0x80, 0xb7, 0xd1, 0xf2, 0x20, 0xd4, 0x47, 0x47, 0x1d, 0x39, 0x72, 0x7c, 0x2a,
+	0xac, 0x72, 0xe3, 0xda, 0x76, 0xc9, 0x5e, 0x4a, 0xec, 0x67, 0xa3, 0x63, 0x2a, 0xf0, 0xff, 0x51,
+	0x05, 0xba, 0x55, 0x8e, 0x20, 0xa0, 0x5a, 0x72, 0x8b, 0xd0, 0xa9, 0x65, 0x65, 0x3e, 0x1b, 0x29,
+	0xb9, 0x63, 0x8a, 0x1f, 0xa0, 0x35, 0x70, 0x4b, 0xb2, 0xd3, 0xcd, 0xe0, 0x53, 0x18, 0xa4, 0x25,
+	0x1b, 0x5a, 0x3e, 0xce, 0x0d, 0x8e, 0xff, 0x98, 0xe2, 0x16, 0xaa, 0xb0, 0xc8, 0xdd, 0xdf, 0x73,
+	0x24, 0x77, 0x28, 0x0b, 0x79, 0x4f, 0x58, 0x15, 0xf8, 0x3d, 0x6b, 0xe9, 0xef, 0x79, 0x6c, 0x1f,
+	0xee, 0xef, 0x9d, 0xf1, 0x96, 0x82, 0xe3, 0x8e, 0x01, 0x92, 0xb1, 0x09, 0x1c, 0xa1, 0x5b, 0xf9,
+	0xfb, 0x94, 0xac, 0x9a, 0x2e, 0xf3, 0xbd, 0xae, 0x84, 0xd1, 0xb7, 0xb0, 0xff, 0x69, 0xaa, 0xf9,
+	0x3c, 0x73, 0xc7, 0x72, 0x5b, 0xe7, 0x29, 0x10, 0x4c, 0x98, 0xcd, 0x60, 0x82, 0x9b, 0xf6, 0xc0,
+	0xa7, 0xa8, 0x9a, 0x1b, 0x7c, 0x70, 0x85, 0x85, 0xb5, 0x52, 0xbc, 0x6b, 0xad, 0xf4, 0xf0, 0xe0,
+	0x32, 0xc7, 0x4d, 0x46, 0x0b, 0x76, 0x81, 0xbb, 0xa8, 0xde, 0x67, 0x21, 0x55, 0xa5, 0x9b, 0x38,
+	0x52, 0x85, 0x85, 0x8b, 0x4d, 0xdc, 0x1a, 0x1f, 0xa8, 0x46, 0x7f, 0xcb, 0x48, 0x4d, 0xf0, 0x10,
+	0xf8, 0x07, 0xb4, 0xd6, 0x8f, 0xd8, 0xd0, 0xe7, 0x03, 0xe1, 0xe4, 0xdb, 0xb2, 0xfa, 0xef, 0x23,
+	0xd4, 0x62, 0x89, 0x56, 0xb6, 0x3d, 0x5f, 0xa2, 0xda, 0x20, 0xec, 0x70, 0xfd, 0x33, 0x86, 0x24,
+	0xf0, 0xa9, 0x6a, 0x65, 0x61, 0xd5, 0x40, 0xf8, 0x66, 0x2a, 0xfc, 0x32, 0xf6, 0x7a, 0x15, 0x3b,
+	0x19, 0xdd, 0xea, 0x60, 0x0c, 0x11, 0xf8, 0x1b, 0x73, 0xc2, 0xb0, 0x8c, 0x18, 0x75, 0x32, 0x57,
+	0x1c, 0xfe, 0x4d, 0x58, 0xab, 0xd0, 0x63, 0x1b, 0xca, 0xa9, 0xad, 0x7d, 0xd2, 0x6b, 0xad, 0x1c,
+	0xf0, 0x33, 0xb4, 0x92, 0x21, 0x99, 0xd3, 0x5a, 0x83, 0xac, 0xac, 0x4c, 0x5f, 0xc4, 0xa4, 0xec,
+	0x59, 0x55, 0x82, 0x9c, 0x55, 0xe0, 0x0e, 0xda, 0xd0, 0x5d, 0x4b, 0x59, 0x3f, 0xe0, 0x57, 0x3d,
+	0x16, 0xaa, 0xcd, 0xf3, 0xf3, 0x80, 0x09, 0x29, 0xac, 0x75, 0xd0, 0x6c, 0x14, 0xfa, 0xb7, 0x95,
+	0x78, 0xda, 0xda, 0xd1, 0x68, 0xaf, 0x83, 0xd0, 0x18, 0x2a, 0xf0, 0x31, 0x5a, 0x2e, 0x2c, 0x28,
+	0x61, 0x59, 0xc5, 0x74, 0xcf, 0x72, 0x1b, 0x2a, 0x4e, 0x37, 0xbf, 0xb7, 0x40, 0xaa, 0xb0, 0x27,
+	0x85, 0xb5, 0x51, 0x94, 0x6a, 0xe5, 0x16, 0x65, 0x2c, 0x95, 0x5f, 0x9f, 0x02, 0xbf, 0x42, 0xab,
+	0xe7, 0x24, 0x08, 0x3a, 0xc4, 0xbd, 0x70, 0xc4, 0x25, 0x63, 0xfd, 0xb8, 0x92, 0x9b, 0xc5, 0xf3,
+	0x3d, 0x32, 0x6e, 0x6d, 0xe5, 0x95, 0xad, 0x66, 0xf5, 0x7c, 0x0c, 0x51, 0xbd, 0xbf, 0xa5, 0x2b,
+	0xda, 0x63, 0x92, 0x50, 0x22, 0x89, 0x93, 0x9b, 0xb7, 0x5b, 0xa0, 0xde, 0x2c, 0xd4, 0xf4, 0x85,
+	0xf1, 0x1d, 0x1f, 0xbe, 0xfa, 0x78, 0x26, 0xe0, 0xa2, 0x79, 0x82, 0x56, 0xc6, 0x06, 0x24, 0x5e,
+	0x42, 0x33, 0xe6, 0x35, 0xa2, 0x64, 0xcf, 0xf8, 0x14, 0xdf, 0x45, 0x33, 0x72, 0x04, 0xfb, 0x78,
+	0x61, 0xbf, 0x36, 0x71, 0xf5, 0xe9, 0x38, 0x33, 0x72, 0xd4, 0x7c, 0x88, 0xca, 0xd9, 0x09, 0x85,
+	0x6b, 0xe8, 0x3a, 0x44, 0x37, 0x6f, 0x25, 0xfa, 0x41, 0x59, 0x61, 0xbe, 0x99, 0x97, 0x10, 0xfd,
+	0xd0, 0x3c, 0x42, 0xcb, 0xc5, 0xf9, 0x80, 0x6f, 0xa2, 0xf9, 0xe4, 0xde, 0x18, 0x8d, 0xd4, 0xa0,
+	0x74, 0xb2, 0x7f, 0x16, 0xf4, 0x43, 0xf3, 0x2b, 0xb4, 0x94, 0xef, 0x5c, 0xbc, 0x86, 0x66, 0x7b,
+	0x9c, 0x0e, 0x02, 0x66, 0x24, 0xcc, 0xd3, 0x07, 0xf8, 0x6d, 0x84, 0xc7, 0xcf, 0x0b, 0x7f, 0x82,
+	0x2a, 0xc9, 0x20, 0x15, 0x2c, 0xa4, 0x2c, 0xce, 0x67, 0x29, 0x36, 0xb7, 0xc1, 0x3a, 0x59, 0xf4,
+	0xe0, 0xd9, 0xeb, 0x77, 0xf5, 0xe9, 0x37, 0xef, 0xea, 0xd3, 0x7f, 0xbf, 0xab, 0x4f, 0xff, 0xf6,
+	0xbe, 0x3e, 0xf5, 0xe6, 0x7d, 0x7d, 0xea, 0x8f, 0xf7, 0xf5, 0xa9, 0x1f, 0xf7, 0x32, 0xff, 0x3d,
+	0x49, 0x20, 0xbb, 0x8c, 0xdc, 0x0b, 0x99, 0xdc, 0xd5, 0x6f, 0x7c, 0x3a, 0xd1, 0xdd, 0x91, 0x79,
+	0x84, 0x7f, 0xa2, 0x9d, 0x59, 0x78, 0xff, 0x7b, 0xf0, 0xcf, 0x00, 0x9b, 0xf3, 0x5f, 0xc0, 0xab,
+	0x0e, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PeggyId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TransferRecordRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransferRecordRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.DepositReceiptRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositReceiptRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.IbcForwardTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IbcForwardTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	if m.DelegateKeyRotationGracePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegateKeyRotationGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.SignedValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	{
+		size := m.SlashFractionConflictingClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.SlashFractionClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.SignedClaimsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedClaimsWindow))
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.TargetBatchTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x60
 	}
 	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	if m.TargetBatchTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = m.SlashFractionValset.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionClaim.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionConflictingClaim.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	return n
+	if m.SignedClaimsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeggyId) > 0 {
+		i -= len(m.PeggyId)
+		copy(dAtA[i:], m.PeggyId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PeggyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	if len(m.Erc20MetadataAttestations) > 0 {
+		for iNdEx := len(m.Erc20MetadataAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20MetadataAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
 	}
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FallbackSweepNonces) > 0 {
+		for iNdEx := len(m.FallbackSweepNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FallbackSweepNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for _, e := range m.ValsetConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DepositReceipts) > 0 {
+		for iNdEx := len(m.DepositReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.BatchConfirms) > 0 {
-		for _, e := range m.BatchConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Erc20DeploymentRequests) > 0 {
+		for iNdEx := len(m.Erc20DeploymentRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20DeploymentRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.LogicCalls) > 0 {
-		for _, e := range m.LogicCalls {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LogicCallNonces) > 0 {
+		for iNdEx := len(m.LogicCallNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for _, e := range m.LogicCallConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.LastSlashedLogicCallBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.UnbondingValidators) > 0 {
+		for iNdEx := len(m.UnbondingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PreviousDelegateKeys) > 0 {
+		for iNdEx := len(m.PreviousDelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousDelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingDelegateKeyRotations) > 0 {
+		for iNdEx := len(m.PendingDelegateKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDelegateKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.DelegateKeyNonces) > 0 {
+		for iNdEx := len(m.DelegateKeyNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeyNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.LastOutgoingBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LastTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.UnbatchedTxIds) > 0 {
+		dAtA3 := make([]byte, len(m.UnbatchedTxIds)*10)
+		var j2 int
+		for _, num := range m.UnbatchedTxIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.OutgoingPool) > 0 {
+		for iNdEx := len(m.OutgoingPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingPoolEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingPoolEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingPoolEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeyNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeyNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeyNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogicCallNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogicCallNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogicCallNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FallbackSweepNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FallbackSweepNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FallbackSweepNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeggyId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedClaimsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedClaimsWindow))
+	}
+	if m.TargetBatchTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionClaim.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingClaim.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.DelegateKeyRotationGracePeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.DelegateKeyRotationGracePeriod))
+	}
+	if m.IbcForwardTimeout != 0 {
+		n += 2 + sovGenesis(uint64(m.IbcForwardTimeout))
+	}
+	if m.DepositReceiptRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.DepositReceiptRetention))
+	}
+	if m.TransferRecordRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.TransferRecordRetention))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutgoingPool) > 0 {
+		for _, e := range m.OutgoingPool {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTxIds) > 0 {
+		l = 0
+		for _, e := range m.UnbatchedTxIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.LastTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	}
+	if m.LastOutgoingBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastOutgoingBatchId))
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.DelegateKeyNonces) > 0 {
+		for _, e := range m.DelegateKeyNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDelegateKeyRotations) > 0 {
+		for _, e := range m.PendingDelegateKeyRotations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreviousDelegateKeys) > 0 {
+		for _, e := range m.PreviousDelegateKeys {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingValidators) > 0 {
+		for _, e := range m.UnbondingValidators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if len(m.LogicCallNonces) > 0 {
+		for _, e := range m.LogicCallNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20DeploymentRequests) > 0 {
+		for _, e := range m.Erc20DeploymentRequests {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositReceipts) > 0 {
+		for _, e := range m.DepositReceipts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FallbackSweepNonces) > 0 {
+		for _, e := range m.FallbackSweepNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20MetadataAttestations) > 0 {
+		for _, e := range m.Erc20MetadataAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutgoingPoolEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeyNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *LogicCallNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *FallbackSweepNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeggyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeggyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+			}
+			m.SignedClaimsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeyRotationGracePeriod", wireType)
+			}
+			m.DelegateKeyRotationGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateKeyRotationGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcForwardTimeout", wireType)
+			}
+			m.IbcForwardTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcForwardTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceiptRetention", wireType)
+			}
+			m.DepositReceiptRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositReceiptRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecordRetention", wireType)
+			}
+			m.TransferRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -798,30 +2419,221 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+			}
+			m.LastObservedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, &Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeggyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -831,29 +2643,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeggyId = string(dAtA[iNdEx:postIndex])
+			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -863,29 +2677,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -895,29 +2711,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingPool = append(m.OutgoingPool, OutgoingPoolEntry{})
+			if err := m.OutgoingPool[len(m.OutgoingPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbatchedTxIds = append(m.UnbatchedTxIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbatchedTxIds) == 0 {
+					m.UnbatchedTxIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbatchedTxIds = append(m.UnbatchedTxIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTxIds", wireType)
+			}
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTxPoolId", wireType)
 			}
-			m.BridgeChainId = 0
+			m.LastTxPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -927,16 +2889,136 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				m.LastTxPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchId", wireType)
+			}
+			m.LastOutgoingBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutgoingBatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeyNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeyNonces = append(m.DelegateKeyNonces, DelegateKeyNonce{})
+			if err := m.DelegateKeyNonces[len(m.DelegateKeyNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SignedValsetsWindow = 0
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDelegateKeyRotations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -946,16 +3028,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDelegateKeyRotations = append(m.PendingDelegateKeyRotations, DelegateKeyRotation{})
+			if err := m.PendingDelegateKeyRotations[len(m.PendingDelegateKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDelegateKeys", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -965,16 +3062,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedClaimsWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousDelegateKeys = append(m.PreviousDelegateKeys, DelegateKeyRotation{})
+			if err := m.PreviousDelegateKeys[len(m.PreviousDelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValidators", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -984,16 +3096,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingValidators = append(m.UnbondingValidators, UnbondingValidator{})
+			if err := m.UnbondingValidators[len(m.UnbondingValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
 			}
-			m.TargetBatchTimeout = 0
+			m.LastSlashedLogicCallBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1003,16 +3130,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallNonces", wireType)
 			}
-			m.AverageBlockTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1022,16 +3149,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallNonces = append(m.LogicCallNonces, LogicCallNonce{})
+			if err := m.LogicCallNonces[len(m.LogicCallNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20DeploymentRequests", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1041,16 +3183,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20DeploymentRequests = append(m.Erc20DeploymentRequests, ERC20DeploymentRequest{})
+			if err := m.Erc20DeploymentRequests[len(m.Erc20DeploymentRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1060,30 +3217,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceipts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1093,30 +3251,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositReceipts = append(m.DepositReceipts, DepositReceipt{})
+			if err := m.DepositReceipts[len(m.DepositReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackSweepNonces", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1126,30 +3285,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FallbackSweepNonces = append(m.FallbackSweepNonces, FallbackSweepNonce{})
+			if err := m.FallbackSweepNonces[len(m.FallbackSweepNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20MetadataAttestations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1159,22 +3319,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20MetadataAttestations = append(m.Erc20MetadataAttestations, ERC20MetadataAttestation{})
+			if err := m.Erc20MetadataAttestations[len(m.Erc20MetadataAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1202,7 +3363,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *OutgoingPoolEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,15 +3386,34 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutgoingPoolEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutgoingPoolEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1260,37 +3440,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastObservedNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastObservedNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 3:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1300,31 +3511,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, &Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1334,31 +3543,82 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeyNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeyNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeyNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1368,31 +3628,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1402,31 +3660,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogicCallNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogicCallNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogicCallNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1436,31 +3732,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1470,31 +3764,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FallbackSweepNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FallbackSweepNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FallbackSweepNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1504,31 +3836,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1538,26 +3868,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,6 +37,138 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidateSections(t *testing.T) {
+	var (
+		valAddr   = "cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4"
+		otherVal  = "cosmosvaloper15n79nty2fj37ant3p2gj4wju4ls6eu6tjwmdt0"
+		orchAddr  = "cosmos1g0etv93428tvxqftnmj25jn06mz6dtdasj5nz7"
+		otherOrch = "cosmos1rhfs24tlw4na04v35tzmjncy785kkw9j27d5kx"
+		ethAddr   = "0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09"
+		otherEth  = "0x610277F0208D342C576b991daFdCb36E36515e76"
+		contract  = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		signature = strings.Repeat("ab", 65)
+		voucher   = PeggyDenom(contract)
+	)
+	valid := func() *GenesisState {
+		claim, err := codectypes.NewAnyWithValue(&MsgWithdrawClaim{EventNonce: 2, BatchNonce: 1, TokenContract: contract, Orchestrator: orchAddr})
+		require.NoError(t, err)
+		tx := func(id uint64) OutgoingPoolEntry {
+			return OutgoingPoolEntry{Id: id, Tx: OutgoingTx{Sender: orchAddr, DestAddr: ethAddr, Amount: sdk.NewInt64Coin(voucher, 10), BridgeFee: sdk.NewInt64Coin(voucher, 1)}}
+		}
+		return &GenesisState{
+			Params:            DefaultParams(),
+			LastObservedNonce: 2,
+			Valsets:           []*Valset{{Nonce: 1, Members: []*BridgeValidator{{Power: 1, EthereumAddress: ethAddr}, {Power: 1}}}},
+			ValsetConfirms:    []*MsgValsetConfirm{{Nonce: 1, Orchestrator: orchAddr, EthAddress: ethAddr, Signature: signature}},
+			Batches: []*OutgoingTxBatch{{BatchNonce: 1, TokenContract: contract, Transactions: []*OutgoingTransferTx{
+				{Id: 2, Sender: orchAddr, DestAddress: ethAddr, Erc20Token: NewERC20Token(10, contract), Erc20Fee: NewERC20Token(1, contract)},
+			}}},
+			BatchConfirms:               []MsgConfirmBatch{{Nonce: 1, TokenContract: contract, EthSigner: ethAddr, Orchestrator: orchAddr, Signature: signature}},
+			LogicCalls:                  []*OutgoingLogicCall{{LogicContractAddress: contract, InvalidationId: []byte{1}, InvalidationNonce: 1, SourceModule: "gov", Transfers: []*ERC20Token{NewERC20Token(1, contract)}}},
+			LogicCallConfirms:           []MsgConfirmLogicCall{{InvalidationId: []byte{1}, InvalidationNonce: 1, EthSigner: ethAddr, Orchestrator: orchAddr, Signature: signature}},
+			Attestations:                []Attestation{{Observed: true, Votes: []string{valAddr}, Claim: claim}},
+			DelegateKeys:                []*MsgSetOrchestratorAddress{{Validator: valAddr, Orchestrator: orchAddr, EthAddress: ethAddr}},
+			OutgoingPool:                []OutgoingPoolEntry{tx(1), tx(2)},
+			UnbatchedTxIds:              []uint64{1},
+			TransferRecords:             []TransferRecord{{TxId: 3, State: TRANSFER_STATE_EXECUTED, BatchNonce: 1, EventNonce: 2}},
+			DepositReceipts:             []DepositReceipt{{EventNonce: 1, EthereumSender: ethAddr, CosmosReceiver: orchAddr, TokenContract: contract, Denom: voucher, Amount: sdk.NewInt(5)}},
+			FallbackSweepNonces:         []FallbackSweepNonce{{EthereumSender: ethAddr, Nonce: 1}},
+			LastTxPoolId:                3,
+			LastOutgoingBatchId:         1,
+			Erc20ToDenoms:               []ERC20ToDenom{{Erc20: otherEth, Denom: "stake"}},
+			DelegateKeyNonces:           []DelegateKeyNonce{{Validator: valAddr, Nonce: 1}},
+			PendingDelegateKeyRotations: []DelegateKeyRotation{{Validator: otherVal, Orchestrator: otherOrch, EthAddress: otherEth}},
+			UnbondingValidators:         []UnbondingValidator{{Validator: otherVal, UnbondingHeight: 1}},
+			LogicCallNonces:             []LogicCallNonce{{Module: "gov", Nonce: 1}},
+			Erc20DeploymentRequests:     []ERC20DeploymentRequest{{Denom: "uatom", Name: "Atom", Symbol: "ATOM"}},
+			Erc20MetadataAttestations:   []ERC20MetadataAttestation{{TokenContract: contract, Name: "Dai", Symbol: "DAI", Decimals: 18, Votes: []string{valAddr}}},
+		}
+	}
+	specs := map[string]struct {
+		mutate func(*GenesisState)
+		expErr bool
+	}{
+		"all sections":          {mutate: func(*GenesisState) {}},
+		"nil params":            {mutate: func(s *GenesisState) { s.Params = nil }, expErr: true},
+		"duplicate valset":      {mutate: func(s *GenesisState) { s.Valsets = append(s.Valsets, s.Valsets[0]) }, expErr: true},
+		"invalid valset member": {mutate: func(s *GenesisState) { s.Valsets[0].Members[0].EthereumAddress = "0xinvalid" }, expErr: true},
+		"duplicate valset member": {mutate: func(s *GenesisState) {
+			s.Valsets[0].Members[1].EthereumAddress = ethAddr
+		}, expErr: true},
+		"valset confirm of unknown valset": {mutate: func(s *GenesisState) { s.ValsetConfirms[0].Nonce = 2 }, expErr: true},
+		"malformed valset signature":       {mutate: func(s *GenesisState) { s.ValsetConfirms[0].Signature = "zz" }, expErr: true},
+		"batch confirm of unknown batch":   {mutate: func(s *GenesisState) { s.BatchConfirms[0].Nonce = 2 }, expErr: true},
+		"short batch signature":            {mutate: func(s *GenesisState) { s.BatchConfirms[0].Signature = "abab" }, expErr: true},
+		"logic confirm of unknown call":    {mutate: func(s *GenesisState) { s.LogicCallConfirms[0].InvalidationNonce = 2 }, expErr: true},
+		"logic call ahead of module nonce": {mutate: func(s *GenesisState) { s.LogicCallNonces = nil }, expErr: true},
+		"observed attestation ahead":       {mutate: func(s *GenesisState) { s.LastObservedNonce = 1 }, expErr: true},
+		"unobserved attestation ahead": {mutate: func(s *GenesisState) {
+			s.LastObservedNonce = 1
+			s.Attestations[0].Observed = false
+		}},
+		"attestation without claim": {mutate: func(s *GenesisState) { s.Attestations[0].Claim = nil }, expErr: true},
+		"invalid attestation vote":  {mutate: func(s *GenesisState) { s.Attestations[0].Votes = []string{orchAddr} }, expErr: true},
+		"duplicate validator delegate keys": {mutate: func(s *GenesisState) {
+			s.DelegateKeys = append(s.DelegateKeys, &MsgSetOrchestratorAddress{Validator: valAddr, Orchestrator: otherOrch, EthAddress: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"})
+		}, expErr: true},
+		"orchestrator used twice": {mutate: func(s *GenesisState) {
+			s.DelegateKeys = append(s.DelegateKeys, &MsgSetOrchestratorAddress{Validator: otherVal, Orchestrator: orchAddr, EthAddress: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"})
+		}, expErr: true},
+		"eth address of pending rotation in use": {mutate: func(s *GenesisState) {
+			s.PendingDelegateKeyRotations[0].EthAddress = ethAddr
+		}, expErr: true},
+		"batched tx also unbatched": {mutate: func(s *GenesisState) { s.UnbatchedTxIds = []uint64{1, 2} }, expErr: true},
+		"batched tx not in pool":    {mutate: func(s *GenesisState) { s.OutgoingPool = s.OutgoingPool[:1] }, expErr: true},
+		"pool tx neither batched nor unbatched": {mutate: func(s *GenesisState) {
+			s.UnbatchedTxIds = nil
+		}, expErr: true},
+		"pool tx above last id":         {mutate: func(s *GenesisState) { s.LastTxPoolId = 1 }, expErr: true},
+		"transfer record above last id": {mutate: func(s *GenesisState) { s.TransferRecords[0].TxId = 4 }, expErr: true},
+		"transfer record of pool tx":    {mutate: func(s *GenesisState) { s.TransferRecords[0].TxId = 1 }, expErr: true},
+		"pending transfer record": {mutate: func(s *GenesisState) {
+			s.TransferRecords[0].State = TRANSFER_STATE_IN_BATCH
+		}, expErr: true},
+		"duplicate transfer record": {mutate: func(s *GenesisState) {
+			s.TransferRecords = append(s.TransferRecords, s.TransferRecords[0])
+		}, expErr: true},
+		"deposit receipt above last observed nonce": {mutate: func(s *GenesisState) { s.DepositReceipts[0].EventNonce = 3 }, expErr: true},
+		"duplicate deposit receipt": {mutate: func(s *GenesisState) {
+			s.DepositReceipts = append(s.DepositReceipts, s.DepositReceipts[0])
+		}, expErr: true},
+		"invalid deposit receiver":      {mutate: func(s *GenesisState) { s.DepositReceipts[0].CosmosReceiver = valAddr }, expErr: true},
+		"invalid fallback sweep sender": {mutate: func(s *GenesisState) { s.FallbackSweepNonces[0].EthereumSender = "0x" }, expErr: true},
+		"duplicate fallback sweep nonce": {mutate: func(s *GenesisState) {
+			s.FallbackSweepNonces = append(s.FallbackSweepNonces, FallbackSweepNonce{EthereumSender: strings.ToLower(ethAddr), Nonce: 2})
+		}, expErr: true},
+		"batch above last id": {mutate: func(s *GenesisState) { s.LastOutgoingBatchId = 0 }, expErr: true},
+		"unbatched tx without erc20": {mutate: func(s *GenesisState) {
+			s.OutgoingPool[0].Tx.Amount.Denom = "uatom"
+			s.OutgoingPool[0].Tx.BridgeFee.Denom = "uatom"
+		}, expErr: true},
+		"duplicate denom mapping": {mutate: func(s *GenesisState) {
+			s.Erc20ToDenoms = append(s.Erc20ToDenoms, ERC20ToDenom{Erc20: contract, Denom: "stake"})
+		}, expErr: true},
+		"second erc20 metadata vote of a validator": {mutate: func(s *GenesisState) {
+			s.Erc20MetadataAttestations = append(s.Erc20MetadataAttestations, ERC20MetadataAttestation{TokenContract: contract, Name: "Fake", Symbol: "FAKE", Votes: []string{valAddr}})
+		}, expErr: true},
+		"duplicate unbonding validator": {mutate: func(s *GenesisState) {
+			s.UnbondingValidators = append(s.UnbondingValidators, UnbondingValidator{Validator: otherVal, UnbondingHeight: 2})
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			src := valid()
+			spec.mutate(src)
+			err := src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
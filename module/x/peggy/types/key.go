@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -16,6 +17,10 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	// ConsensusVersion is the version of the store layout of the module, it is bumped
+	// with every migration of the store
+	ConsensusVersion = 6
 )
 
 var (
@@ -96,6 +101,62 @@ var (
 
 	// ERC20ToDenomKey prefixes the index of Cosmos originated assets ERC20s to denoms
 	ERC20ToDenomKey = []byte{0xf4}
+
+	// KeyValidatorOrchestrator indexes the orchestrator key for a validator,
+	// this is the reverse of KeyOrchestratorAddress
+	KeyValidatorOrchestrator = []byte{0xe9}
+
+	// KeyEthAddressValidator indexes the validator for an ethereum address,
+	// this is the reverse of EthAddressKey
+	KeyEthAddressValidator = []byte{0xea}
+
+	// KeyDelegateKeyNonce indexes the delegate key nonce of a validator
+	KeyDelegateKeyNonce = []byte{0xeb}
+
+	// KeyPendingDelegateKeyRotation indexes scheduled delegate key rotations by validator
+	KeyPendingDelegateKeyRotation = []byte{0xec}
+
+	// KeyPreviousDelegateKeys indexes the delegate keys a validator rotated away from
+	KeyPreviousDelegateKeys = []byte{0xed}
+
+	// KeyPreviousOrchestrator indexes the validator for an orchestrator key it rotated away from
+	KeyPreviousOrchestrator = []byte{0xee}
+
+	// KeyUnbondingValidator indexes validators that are still liable for signing after leaving the bonded set
+	KeyUnbondingValidator = []byte{0xf5}
+
+	// KeyLastSlashedLogicCallBlock indexes the creation height up to which logic calls were checked for missing signatures
+	KeyLastSlashedLogicCallBlock = []byte{0xf6}
+
+	// KeyLastLogicCallNonce indexes the last invalidation nonce assigned to the logic calls of a module
+	KeyLastLogicCallNonce = []byte{0xf7}
+
+	// KeyERC20DeploymentRequest indexes the ERC20 deployments approved by governance by denom
+	KeyERC20DeploymentRequest = []byte{0xf8}
+
+	// KeyStoreVersion indexes the consensus version the store was last migrated to
+	KeyStoreVersion = []byte{0xfa}
+
+	// KeyTransferRecord indexes the outcome of transfers that left the outgoing pool by tx id
+	KeyTransferRecord = []byte{0xfb}
+
+	// KeyTransferRecordByHeight indexes the transfer records by the height they were recorded at
+	KeyTransferRecordByHeight = []byte{0xe7}
+
+	// KeyDepositReceipt indexes the receipts of credited deposits by event nonce
+	KeyDepositReceipt = []byte{0xfc}
+
+	// KeyDepositReceiptByReceiver indexes the event nonces of deposit receipts by receiver
+	KeyDepositReceiptByReceiver = []byte{0xfd}
+
+	// KeyDepositReceiptBySender indexes the event nonces of deposit receipts by Ethereum sender
+	KeyDepositReceiptBySender = []byte{0xfe}
+
+	// KeyFallbackSweepNonce indexes the sweep nonces of fallback accounts by Ethereum sender
+	KeyFallbackSweepNonce = []byte{0xff}
+
+	// KeyERC20MetadataAttestation indexes the attestations of ERC20 metadata by token contract and claim hash
+	KeyERC20MetadataAttestation = []byte{0xef}
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -112,6 +173,55 @@ func GetEthAddressKey(validator sdk.ValAddress) []byte {
 	return append(EthAddressKey, validator.Bytes()...)
 }
 
+// GetValidatorOrchestratorKey returns the following key format
+// prefix              cosmos-validator
+// [0xe9][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetValidatorOrchestratorKey(validator sdk.ValAddress) []byte {
+	return append(KeyValidatorOrchestrator, validator.Bytes()...)
+}
+
+// GetEthAddressValidatorKey returns the following key format
+// prefix              eth-address
+// [0xea][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetEthAddressValidatorKey(ethAddr string) []byte {
+	return append(KeyEthAddressValidator, []byte(NormalizeEthAddress(ethAddr))...)
+}
+
+// GetDelegateKeyNonceKey returns the following key format
+// prefix              cosmos-validator
+// [0xeb][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetDelegateKeyNonceKey(validator sdk.ValAddress) []byte {
+	return append(KeyDelegateKeyNonce, validator.Bytes()...)
+}
+
+// GetPendingDelegateKeyRotationKey returns the following key format
+// prefix              cosmos-validator
+// [0xec][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetPendingDelegateKeyRotationKey(validator sdk.ValAddress) []byte {
+	return append(KeyPendingDelegateKeyRotation, validator.Bytes()...)
+}
+
+// GetPreviousDelegateKeysKey returns the following key format
+// prefix              cosmos-validator
+// [0xed][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetPreviousDelegateKeysKey(validator sdk.ValAddress) []byte {
+	return append(KeyPreviousDelegateKeys, validator.Bytes()...)
+}
+
+// GetPreviousOrchestratorKey returns the following key format
+// prefix              cosmos-orchestrator
+// [0xee][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetPreviousOrchestratorKey(orch sdk.AccAddress) []byte {
+	return append(KeyPreviousOrchestrator, orch.Bytes()...)
+}
+
+// GetUnbondingValidatorKey returns the following key format
+// prefix              cosmos-validator-address
+// [0xf5][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetUnbondingValidatorKey(validator sdk.ValAddress) []byte {
+	return append(KeyUnbondingValidator, validator.Bytes()...)
+}
+
 // GetValsetKey returns the following key format
 // prefix    nonce
 // [0x0][0 0 0 0 0 0 0 1]
@@ -188,38 +298,94 @@ func GetOutgoingTxPoolKey(id uint64) []byte {
 	return append(OutgoingTXPoolKey, sdk.Uint64ToBigEndian(id)...)
 }
 
+// GetTransferRecordKey returns the following key format
+// prefix     id
+// [0xfb][0 0 0 0 0 0 0 1]
+func GetTransferRecordKey(id uint64) []byte {
+	return append(KeyTransferRecord, UInt64Bytes(id)...)
+}
+
+// GetTransferRecordByHeightKey returns the following key format
+// prefix     height             id
+// [0xe7][0 0 0 0 0 0 0 9][0 0 0 0 0 0 0 1]
+func GetTransferRecordByHeightKey(height, id uint64) []byte {
+	return append(KeyTransferRecordByHeight, append(UInt64Bytes(height), UInt64Bytes(id)...)...)
+}
+
+// GetDepositReceiptKey returns the following key format
+// prefix     nonce
+// [0xfc][0 0 0 0 0 0 0 1]
+func GetDepositReceiptKey(nonce uint64) []byte {
+	return append(KeyDepositReceipt, UInt64Bytes(nonce)...)
+}
+
+// GetDepositReceiptByReceiverKey returns the following key format
+// prefix     cosmos-address                                 nonce
+// [0xfd][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetDepositReceiptByReceiverKey(receiver sdk.AccAddress, nonce uint64) []byte {
+	return append(KeyDepositReceiptByReceiver, append(receiver.Bytes(), UInt64Bytes(nonce)...)...)
+}
+
+// GetDepositReceiptBySenderKey returns the following key format
+// prefix     eth-address (20 bytes)                         nonce
+// [0xfe][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetDepositReceiptBySenderKey(sender string, nonce uint64) []byte {
+	return append(KeyDepositReceiptBySender, append(EthAddressBytes(sender), UInt64Bytes(nonce)...)...)
+}
+
+// GetFallbackSweepNonceKey returns the following key format
+// prefix     eth-address (20 bytes)
+// [0xff][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetFallbackSweepNonceKey(sender string) []byte {
+	return append(KeyFallbackSweepNonce, EthAddressBytes(sender)...)
+}
+
 // GetOutgoingTxBatchKey returns the following key format
-// prefix     nonce                     eth-contract-address
-// [0xa][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// prefix     eth-contract-address (20 bytes)                nonce
+// [0xa][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
 func GetOutgoingTxBatchKey(tokenContract string, nonce uint64) []byte {
-	return append(append(OutgoingTXBatchKey, []byte(tokenContract)...), UInt64Bytes(nonce)...)
+	return append(GetOutgoingTxBatchContractPrefix(tokenContract), UInt64Bytes(nonce)...)
+}
+
+// GetOutgoingTxBatchContractPrefix returns the prefix of the batches of a token contract
+// prefix     eth-contract-address (20 bytes)
+// [0xa][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetOutgoingTxBatchContractPrefix(tokenContract string) []byte {
+	return append(OutgoingTXBatchKey, EthAddressBytes(tokenContract)...)
 }
 
 // GetBatchConfirmKey returns the following key format
-// prefix           eth-contract-address                BatchNonce                       Validator-address
+// prefix           eth-contract-address (20 bytes)                BatchNonce                       Validator-address
 // [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 // TODO this should be a sdk.ValAddress
 func GetBatchConfirmKey(tokenContract string, batchNonce uint64, validator sdk.AccAddress) []byte {
-	a := append(UInt64Bytes(batchNonce), validator.Bytes()...)
-	b := append([]byte(tokenContract), a...)
-	c := append(BatchConfirmKey, b...)
-	return c
+	return append(GetBatchConfirmNoncePrefix(tokenContract, batchNonce), validator.Bytes()...)
+}
+
+// GetBatchConfirmNoncePrefix returns the prefix of the confirms of a batch
+// prefix           eth-contract-address (20 bytes)                BatchNonce
+// [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchConfirmNoncePrefix(tokenContract string, batchNonce uint64) []byte {
+	a := append(BatchConfirmKey, EthAddressBytes(tokenContract)...)
+	return append(a, UInt64Bytes(batchNonce)...)
 }
 
 // GetFeeSecondIndexKey returns the following key format
-// prefix            eth-contract-address            fee_amount
+// prefix            eth-contract-address (20 bytes)            fee_amount (32 bytes)
 // [0x9][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000]
 func GetFeeSecondIndexKey(tokenContract string, fee sdk.Coin) []byte {
-	r := make([]byte, 1+ETHContractAddressLen+32)
 	// sdkInts have a size limit of 255 bits or 32 bytes
 	// therefore this will never panic and is always safe
 	amount := make([]byte, 32)
 	amount = fee.Amount.BigInt().FillBytes(amount)
-	// TODO this won't ever work fix it
-	copy(r[0:], SecondIndexOutgoingTXFeeKey)
-	copy(r[len(SecondIndexOutgoingTXFeeKey):], []byte(tokenContract))
-	copy(r[len(SecondIndexOutgoingTXFeeKey)+len(tokenContract):], amount)
-	return r
+	return append(GetFeeSecondIndexContractPrefix(tokenContract), amount...)
+}
+
+// GetFeeSecondIndexContractPrefix returns the prefix of the fee index of a token contract
+// prefix            eth-contract-address (20 bytes)
+// [0x9][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetFeeSecondIndexContractPrefix(tokenContract string) []byte {
+	return append(SecondIndexOutgoingTXFeeKey, EthAddressBytes(tokenContract)...)
 }
 
 // GetLastEventNonceByValidatorKey indexes lateset event nonce by validator
@@ -235,7 +401,7 @@ func GetDenomToERC20Key(denom string) []byte {
 }
 
 func GetERC20ToDenomKey(erc20 string) []byte {
-	return append(ERC20ToDenomKey, []byte(erc20)...)
+	return append(ERC20ToDenomKey, []byte(NormalizeEthAddress(erc20))...)
 }
 
 func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []byte {
@@ -243,6 +409,33 @@ func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []
 	return append(a, UInt64Bytes(invalidationNonce)...)
 }
 
+// GetLastLogicCallNonceKey returns the following key format
+// prefix   module name
+// [0xf7][gov]
+func GetLastLogicCallNonceKey(module string) []byte {
+	return append(KeyLastLogicCallNonce, []byte(module)...)
+}
+
+// GetERC20DeploymentRequestKey returns the following key format
+// prefix   denom
+// [0xf8][uatom]
+func GetERC20DeploymentRequestKey(denom string) []byte {
+	return append(KeyERC20DeploymentRequest, []byte(denom)...)
+}
+
+// GetERC20MetadataAttestationKey returns the following key format
+// prefix   token-contract                                claim-hash
+// [0xef][0xdAC17F958D2ee523a2206206994597C13D831ec7][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+func GetERC20MetadataAttestationKey(tokenContract string, claimHash []byte) []byte {
+	return append(append(KeyERC20MetadataAttestation, []byte(tokenContract)...), claimHash...)
+}
+
+// GetLogicCallInvalidationID returns the invalidation id shared by all logic calls of a
+// module, a newer call of a module invalidates its older calls once executed on Ethereum
+func GetLogicCallInvalidationID(module string) []byte {
+	return crypto.Keccak256([]byte(ModuleName), []byte(module))
+}
+
 func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
 	interm := append(KeyOutgoingLogicConfirm, invalidationId...)
 	interm = append(interm, UInt64Bytes(invalidationNonce)...)
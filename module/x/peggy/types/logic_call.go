@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PackLogicCallPayload ABI encodes a call of the method of a contract, the arguments are given
+// as strings and converted to the types the method expects. Integers can be given in decimal
+// or 0x prefixed hex, bytes as 0x prefixed hex. Array and tuple arguments are not supported.
+func PackLogicCallPayload(abiJSON string, method string, args []string) ([]byte, error) {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract ABI")
+	}
+	m, ok := contractABI.Methods[method]
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrUnknown, "method %s", method)
+	}
+	if len(args) != len(m.Inputs) {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "method %s expects %d arguments, got %d", method, len(m.Inputs), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, input := range m.Inputs {
+		if values[i], err = parseABIArgument(input.Type, args[i]); err != nil {
+			return nil, sdkerrors.Wrapf(err, "argument %d (%s %s)", i, input.Type.String(), input.Name)
+		}
+	}
+	return contractABI.Pack(method, values...)
+}
+
+// parseABIArgument converts a string into the Go type the ABI encoder expects for the type
+func parseABIArgument(typ abi.Type, arg string) (interface{}, error) {
+	switch typ.T {
+	case abi.AddressTy:
+		if err := ValidateEthAddress(arg); err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(arg), nil
+	case abi.BoolTy:
+		return strconv.ParseBool(arg)
+	case abi.StringTy:
+		return arg, nil
+	case abi.BytesTy:
+		return hexutil.Decode(arg)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != typ.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(bz))
+		}
+		v := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(bz))
+		return v.Interface(), nil
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(arg, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", arg)
+		}
+		// the range of an N bit integer is [0, 2^N) or [-2^(N-1), 2^(N-1))
+		limit, lower := new(big.Int).Lsh(big.NewInt(1), uint(typ.Size)), big.NewInt(0)
+		if typ.T == abi.IntTy {
+			limit.Rsh(limit, 1)
+			lower.Neg(limit)
+		}
+		if n.Cmp(lower) < 0 || n.Cmp(limit) >= 0 {
+			return nil, fmt.Errorf("integer %s out of range of %s", arg, typ.String())
+		}
+		// up to 64 bits the encoder expects the matching Go integer type
+		v := reflect.New(typ.GetType()).Elem()
+		switch v.Kind() {
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v.SetUint(n.Uint64())
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(n.Int64())
+		default:
+			return n, nil
+		}
+		return v.Interface(), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrUnsupported, "argument type %s", typ.String())
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLogicContractABI = `[{
+	"name": "grant",
+	"type": "function",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "recipient", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "period", "type": "uint32"},
+		{"name": "delta", "type": "int8"},
+		{"name": "vested", "type": "bool"},
+		{"name": "memo", "type": "string"},
+		{"name": "id", "type": "bytes4"},
+		{"name": "data", "type": "bytes"}
+	],
+	"outputs": []
+}]`
+
+func TestPackLogicCallPayload(t *testing.T) {
+	recipient := "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	args := []string{recipient, "1000000000000000000000", "0x10", "-128", "true", "hello", "0x01020304", "0xcafe"}
+
+	payload, err := PackLogicCallPayload(testLogicContractABI, "grant", args)
+	require.NoError(t, err)
+
+	contractABI, err := abi.JSON(strings.NewReader(testLogicContractABI))
+	require.NoError(t, err)
+	amount, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	exp, err := contractABI.Pack("grant", common.HexToAddress(recipient), amount, uint32(16), int8(-128), true, "hello", [4]byte{1, 2, 3, 4}, []byte{0xca, 0xfe})
+	require.NoError(t, err)
+	assert.Equal(t, exp, payload)
+
+	specs := map[string][]string{
+		"unknown method":    nil,
+		"too few arguments": args[:7],
+		"invalid address":   {"0xdeadbeef", args[1], args[2], args[3], args[4], args[5], args[6], args[7]},
+		"negative uint":     {args[0], "-1", args[2], args[3], args[4], args[5], args[6], args[7]},
+		"uint32 overflow":   {args[0], args[1], "4294967296", args[3], args[4], args[5], args[6], args[7]},
+		"int8 overflow":     {args[0], args[1], args[2], "128", args[4], args[5], args[6], args[7]},
+		"fixed bytes size":  {args[0], args[1], args[2], args[3], args[4], args[5], "0x0102", args[7]},
+		"invalid bool":      {args[0], args[1], args[2], args[3], "maybe", args[5], args[6], args[7]},
+	}
+	for msg, specArgs := range specs {
+		t.Run(msg, func(t *testing.T) {
+			method := "grant"
+			if specArgs == nil {
+				method = "unknown"
+			}
+			_, err := PackLogicCallPayload(testLogicContractABI, method, specArgs)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -3,9 +3,12 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
@@ -15,14 +18,17 @@ var (
 	_ sdk.Msg = &MsgRequestBatch{}
 	_ sdk.Msg = &MsgConfirmBatch{}
 	_ sdk.Msg = &MsgSetOrchestratorAddress{}
+	_ sdk.Msg = &MsgRotateDelegateKeys{}
+	_ sdk.Msg = &MsgSweepFallbackAccount{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
-func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth string) *MsgSetOrchestratorAddress {
+func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth string, ethSig string) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
 		Validator:    val.String(),
 		Orchestrator: oper.String(),
 		EthAddress:   eth,
+		EthSignature: ethSig,
 	}
 }
 
@@ -34,6 +40,21 @@ func (msg *MsgSetOrchestratorAddress) Type() string { return "set_operator_addre
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
+	if err := msg.ValidateKeys(); err != nil {
+		return err
+	}
+	if len(msg.EthSignature) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	if _, err := hex.DecodeString(msg.EthSignature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not hex decode ethereum signature: %s", msg.EthSignature)
+	}
+	return nil
+}
+
+// ValidateKeys performs stateless checks on the delegate keys alone, delegate
+// keys imported from genesis carry no ethereum signature
+func (msg *MsgSetOrchestratorAddress) ValidateKeys() (err error) {
 	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
 	}
@@ -51,13 +72,144 @@ func (msg *MsgSetOrchestratorAddress) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
-// GetSigners defines whose signature is required
+// GetSigners defines whose signature is required, the orchestrator co-signs
+// to prove that the validator controls the key it is delegating to
 func (msg *MsgSetOrchestratorAddress) GetSigners() []sdk.AccAddress {
 	acc, err := sdk.ValAddressFromBech32(msg.Validator)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{sdk.AccAddress(acc)}
+	orch, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	if orch.Equals(sdk.AccAddress(acc)) {
+		return []sdk.AccAddress{sdk.AccAddress(acc)}
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc), orch}
+}
+
+// NewMsgRotateDelegateKeys returns a new msgRotateDelegateKeys
+func NewMsgRotateDelegateKeys(val sdk.ValAddress, oper sdk.AccAddress, eth string, ethSig string) *MsgRotateDelegateKeys {
+	return &MsgRotateDelegateKeys{
+		Validator:    val.String(),
+		Orchestrator: oper.String(),
+		EthAddress:   eth,
+		EthSignature: ethSig,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRotateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRotateDelegateKeys) Type() string { return "rotate_delegate_keys" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRotateDelegateKeys) ValidateBasic() (err error) {
+	keys := MsgSetOrchestratorAddress{
+		Validator:    msg.Validator,
+		Orchestrator: msg.Orchestrator,
+		EthAddress:   msg.EthAddress,
+		EthSignature: msg.EthSignature,
+	}
+	return keys.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRotateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required, the new orchestrator co-signs
+// to prove that the validator controls the key it is delegating to
+func (msg *MsgRotateDelegateKeys) GetSigners() []sdk.AccAddress {
+	keys := MsgSetOrchestratorAddress{
+		Validator:    msg.Validator,
+		Orchestrator: msg.Orchestrator,
+	}
+	return keys.GetSigners()
+}
+
+// GetDelegateKeysSignHash returns the hash the ethereum key has to sign
+// to be registered as the delegate ethereum key of a validator
+func GetDelegateKeysSignHash(peggyID string, validator sdk.ValAddress, nonce uint64) []byte {
+	signMsg := DelegateKeysSignMsg{
+		PeggyId:          peggyID,
+		ValidatorAddress: validator.String(),
+		Nonce:            nonce,
+	}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz).Bytes()
+}
+
+// NewMsgSweepFallbackAccount returns a new msgSweepFallbackAccount
+func NewMsgSweepFallbackAccount(ethereumSender string, receiver sdk.AccAddress, ethSig string, submitter sdk.AccAddress) *MsgSweepFallbackAccount {
+	return &MsgSweepFallbackAccount{
+		EthereumSender: ethereumSender,
+		Receiver:       receiver.String(),
+		EthSignature:   ethSig,
+		Submitter:      submitter.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgSweepFallbackAccount) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSweepFallbackAccount) Type() string { return "sweep_fallback_account" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSweepFallbackAccount) ValidateBasic() (err error) {
+	if err := ValidateEthAddress(msg.EthereumSender); err != nil {
+		return sdkerrors.Wrap(err, "ethereum sender")
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Receiver)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Submitter)
+	}
+	if len(msg.EthSignature) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	if _, err := hex.DecodeString(msg.EthSignature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not hex decode ethereum signature: %s", msg.EthSignature)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSweepFallbackAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required, the Ethereum key authorizes the
+// sweep so any account can submit it
+func (msg *MsgSweepFallbackAccount) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// GetFallbackSweepSignHash returns the hash the ethereum key of a deposit sender has
+// to sign to move the coins held by its fallback account to the receiver
+func GetFallbackSweepSignHash(peggyID string, receiver sdk.AccAddress, nonce uint64) []byte {
+	signMsg := FallbackSweepSignMsg{
+		PeggyId:  peggyID,
+		Receiver: receiver.String(),
+		Nonce:    nonce,
+	}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz).Bytes()
 }
 
 // NewMsgValsetConfirm returns a new msgValsetConfirm
@@ -289,6 +441,50 @@ type EthereumClaim interface {
 	ClaimHash() []byte
 }
 
+// NormalizeClaim checksums the ethereum addresses of a claim in place, claims are normalized
+// before they are hashed so that votes in any casing count towards the same attestation
+func NormalizeClaim(claim EthereumClaim) {
+	switch c := claim.(type) {
+	case *MsgDepositClaim:
+		c.TokenContract = NormalizeEthAddress(c.TokenContract)
+		c.EthereumSender = NormalizeEthAddress(c.EthereumSender)
+	case *MsgWithdrawClaim:
+		c.TokenContract = NormalizeEthAddress(c.TokenContract)
+	case *MsgERC20DeployedClaim:
+		c.TokenContract = NormalizeEthAddress(c.TokenContract)
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Attestation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var claim EthereumClaim
+	return unpacker.UnpackAny(a.Claim, &claim)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e AttestationEntry) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return e.Attestation.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryAttestationsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackAttestationEntries(unpacker, r.Attestations)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryAttestationsByNonceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackAttestationEntries(unpacker, r.Attestations)
+}
+
+func unpackAttestationEntries(unpacker codectypes.AnyUnpacker, entries []AttestationEntry) error {
+	for i := range entries {
+		if err := entries[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var (
 	_ EthereumClaim = &MsgDepositClaim{}
 	_ EthereumClaim = &MsgWithdrawClaim{}
@@ -303,8 +499,8 @@ func (e *MsgDepositClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (e *MsgDepositClaim) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(e.CosmosReceiver); err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.CosmosReceiver)
+	if err := ValidateCosmosReceiver(e.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(err, "cosmos receiver")
 	}
 	if err := ValidateEthAddress(e.EthereumSender); err != nil {
 		return sdkerrors.Wrap(err, "eth sender")
@@ -538,3 +734,60 @@ func (b *MsgLogicCallExecutedClaim) ClaimHash() []byte {
 	path := fmt.Sprintf("%s/%d/", b.InvalidationId, b.InvalidationNonce)
 	return tmhash.Sum([]byte(path))
 }
+
+// MsgERC20MetadataClaim
+// ======================
+
+// ValidateBasic performs stateless checks
+func (e *MsgERC20MetadataClaim) ValidateBasic() error {
+	if err := ValidateEthAddress(e.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "erc20 token")
+	}
+	// ERC20 decimals are an uint8
+	if e.Decimals > math.MaxUint8 {
+		return sdkerrors.Wrap(ErrInvalid, "decimals")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgERC20MetadataClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgERC20MetadataClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgERC20MetadataClaim) Type() string { return "ERC20_metadata_claim" }
+
+// Route should return the name of the module
+func (msg MsgERC20MetadataClaim) Route() string { return RouterKey }
+
+// ClaimHash hashes the protobuf encoding of the metadata, the orchestrator is left out
+// so that the claims of all validators count towards the same attestation
+func (b *MsgERC20MetadataClaim) ClaimHash() []byte {
+	claim := *b
+	claim.Orchestrator = ""
+	bz, err := claim.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ClaimHash returns the hash of the claims that vote for the metadata of the attestation
+func (a ERC20MetadataAttestation) ClaimHash() []byte {
+	claim := MsgERC20MetadataClaim{TokenContract: a.TokenContract, Name: a.Name, Symbol: a.Symbol, Decimals: a.Decimals}
+	return claim.ClaimHash()
+}
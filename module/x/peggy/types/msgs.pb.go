@@ -44,10 +44,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ETH_ADDRESS
 // This is a hex encoded 0x Ethereum public key that will be used by this validator
 // on Ethereum
+// ETH_SIGNATURE
+// This is a hex encoded signature by the Ethereum key over the hash of a
+// DelegateKeysSignMsg for the peggy id, this validator and its current delegate
+// key nonce, proving that the Ethereum key consents to being used by this validator.
+// The orchestrator key must co-sign the transaction carrying this message
 type MsgSetOrchestratorAddress struct {
 	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
 func (m *MsgSetOrchestratorAddress) Reset()         { *m = MsgSetOrchestratorAddress{} }
@@ -104,6 +110,13 @@ func (m *MsgSetOrchestratorAddress) GetEthAddress() string {
 	return ""
 }
 
+func (m *MsgSetOrchestratorAddress) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
 type MsgSetOrchestratorAddressResponse struct {
 }
 
@@ -140,6 +153,254 @@ func (m *MsgSetOrchestratorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetOrchestratorAddressResponse proto.InternalMessageInfo
 
+// MsgRotateDelegateKeys
+// this message allows a validator that already has delegate keys to replace
+// them. The rotation is not applied at once, it is scheduled to take effect
+// after the delegate_key_rotation_grace_period so orchestrators can pick up
+// the new keys. Once applied the previous keys remain valid for confirms on
+// valsets and batches that where created before the switch, and a new valset
+// is requested so that the new Ethereum key reaches the bridge contract.
+// The fields and signatures follow MsgSetOrchestratorAddress
+type MsgRotateDelegateKeys struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+}
+
+func (m *MsgRotateDelegateKeys) Reset()         { *m = MsgRotateDelegateKeys{} }
+func (m *MsgRotateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeys) ProtoMessage()    {}
+func (*MsgRotateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b6627b296db358, []int{2}
+}
+func (m *MsgRotateDelegateKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeys.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeys proto.InternalMessageInfo
+
+func (m *MsgRotateDelegateKeys) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
+type MsgRotateDelegateKeysResponse struct {
+	EffectiveHeight uint64 `protobuf:"varint,1,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+}
+
+func (m *MsgRotateDelegateKeysResponse) Reset()         { *m = MsgRotateDelegateKeysResponse{} }
+func (m *MsgRotateDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgRotateDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b6627b296db358, []int{3}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeysResponse proto.InternalMessageInfo
+
+func (m *MsgRotateDelegateKeysResponse) GetEffectiveHeight() uint64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+// MsgSweepFallbackAccount
+// this message moves all coins held by the fallback account of an Ethereum
+// sender to a receiver on this chain. Deposits that can not be forwarded over
+// IBC, and forwarded transfers that time out or are refunded, are credited to
+// the fallback account. It has the same 20 bytes as the Ethereum address, so no
+// Cosmos key controls it and the Ethereum key authorizes the sweep instead
+// ETHEREUM_SENDER
+// The hex encoded 0x Ethereum address of the deposit sender
+// RECEIVER
+// The cosmos1... account the coins are moved to
+// ETH_SIGNATURE
+// This is a hex encoded signature by the Ethereum key over the hash of a
+// FallbackSweepSignMsg for the receiver and the current sweep nonce of the
+// fallback account
+// SUBMITTER
+// The cosmos1... account that signs the transaction, anyone can submit it
+type MsgSweepFallbackAccount struct {
+	EthereumSender string `protobuf:"bytes,1,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	Receiver       string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	EthSignature   string `protobuf:"bytes,3,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+	Submitter      string `protobuf:"bytes,4,opt,name=submitter,proto3" json:"submitter,omitempty"`
+}
+
+func (m *MsgSweepFallbackAccount) Reset()         { *m = MsgSweepFallbackAccount{} }
+func (m *MsgSweepFallbackAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepFallbackAccount) ProtoMessage()    {}
+func (*MsgSweepFallbackAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b6627b296db358, []int{4}
+}
+func (m *MsgSweepFallbackAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepFallbackAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepFallbackAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepFallbackAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepFallbackAccount.Merge(m, src)
+}
+func (m *MsgSweepFallbackAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepFallbackAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepFallbackAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepFallbackAccount proto.InternalMessageInfo
+
+func (m *MsgSweepFallbackAccount) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *MsgSweepFallbackAccount) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgSweepFallbackAccount) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
+func (m *MsgSweepFallbackAccount) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+type MsgSweepFallbackAccountResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSweepFallbackAccountResponse) Reset()         { *m = MsgSweepFallbackAccountResponse{} }
+func (m *MsgSweepFallbackAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepFallbackAccountResponse) ProtoMessage()    {}
+func (*MsgSweepFallbackAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b6627b296db358, []int{5}
+}
+func (m *MsgSweepFallbackAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepFallbackAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepFallbackAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepFallbackAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepFallbackAccountResponse.Merge(m, src)
+}
+func (m *MsgSweepFallbackAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepFallbackAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepFallbackAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepFallbackAccountResponse proto.InternalMessageInfo
+
+func (m *MsgSweepFallbackAccountResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgValsetConfirm
 // this is the message sent by the validators when they wish to submit their
 // signatures over the validator set at a given block height. A validator must
@@ -166,7 +427,7 @@ func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
 func (m *MsgValsetConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirm) ProtoMessage()    {}
 func (*MsgValsetConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{2}
+	return fileDescriptor_75b6627b296db358, []int{6}
 }
 func (m *MsgValsetConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +491,7 @@ func (m *MsgValsetConfirmResponse) Reset()         { *m = MsgValsetConfirmRespon
 func (m *MsgValsetConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirmResponse) ProtoMessage()    {}
 func (*MsgValsetConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{3}
+	return fileDescriptor_75b6627b296db358, []int{7}
 }
 func (m *MsgValsetConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +543,7 @@ func (m *MsgSendToEth) Reset()         { *m = MsgSendToEth{} }
 func (m *MsgSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEth) ProtoMessage()    {}
 func (*MsgSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{4}
+	return fileDescriptor_75b6627b296db358, []int{8}
 }
 func (m *MsgSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,14 +600,16 @@ func (m *MsgSendToEth) GetBridgeFee() types.Coin {
 	return types.Coin{}
 }
 
+// MsgSendToEthResponse returns the id of the transfer in the outgoing pool
 type MsgSendToEthResponse struct {
+	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 }
 
 func (m *MsgSendToEthResponse) Reset()         { *m = MsgSendToEthResponse{} }
 func (m *MsgSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEthResponse) ProtoMessage()    {}
 func (*MsgSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{5}
+	return fileDescriptor_75b6627b296db358, []int{9}
 }
 func (m *MsgSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,6 +638,13 @@ func (m *MsgSendToEthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendToEthResponse proto.InternalMessageInfo
 
+func (m *MsgSendToEthResponse) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
 // MsgRequestBatch
 // this is a message anyone can send that requests a batch of transactions to
 // send across the bridge be created for whatever block height this message is
@@ -393,7 +663,7 @@ func (m *MsgRequestBatch) Reset()         { *m = MsgRequestBatch{} }
 func (m *MsgRequestBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatch) ProtoMessage()    {}
 func (*MsgRequestBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{6}
+	return fileDescriptor_75b6627b296db358, []int{10}
 }
 func (m *MsgRequestBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +713,7 @@ func (m *MsgRequestBatchResponse) Reset()         { *m = MsgRequestBatchResponse
 func (m *MsgRequestBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchResponse) ProtoMessage()    {}
 func (*MsgRequestBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{7}
+	return fileDescriptor_75b6627b296db358, []int{11}
 }
 func (m *MsgRequestBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +762,7 @@ func (m *MsgConfirmBatch) Reset()         { *m = MsgConfirmBatch{} }
 func (m *MsgConfirmBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatch) ProtoMessage()    {}
 func (*MsgConfirmBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{8}
+	return fileDescriptor_75b6627b296db358, []int{12}
 }
 func (m *MsgConfirmBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +833,7 @@ func (m *MsgConfirmBatchResponse) Reset()         { *m = MsgConfirmBatchResponse
 func (m *MsgConfirmBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatchResponse) ProtoMessage()    {}
 func (*MsgConfirmBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{9}
+	return fileDescriptor_75b6627b296db358, []int{13}
 }
 func (m *MsgConfirmBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +882,7 @@ func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
 func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCall) ProtoMessage()    {}
 func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{10}
+	return fileDescriptor_75b6627b296db358, []int{14}
 }
 func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +953,7 @@ func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCall
 func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
 func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{11}
+	return fileDescriptor_75b6627b296db358, []int{15}
 }
 func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +985,8 @@ var xxx_messageInfo_MsgConfirmLogicCallResponse proto.InternalMessageInfo
 // EthereumBridgeDepositClaim
 // When more than 66% of the active validator set has
 // claimed to have seen the deposit enter the ethereum blockchain coins are
-// issued to the Cosmos address in question
+// issued to the Cosmos address in question. A cosmos_receiver of the form
+// channel-N/{bech32 address on the remote chain} forwards the coins over IBC
 // -------------
 type MsgDepositClaim struct {
 	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
@@ -731,7 +1002,7 @@ func (m *MsgDepositClaim) Reset()         { *m = MsgDepositClaim{} }
 func (m *MsgDepositClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositClaim) ProtoMessage()    {}
 func (*MsgDepositClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{12}
+	return fileDescriptor_75b6627b296db358, []int{16}
 }
 func (m *MsgDepositClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +1080,7 @@ func (m *MsgDepositClaimResponse) Reset()         { *m = MsgDepositClaimResponse
 func (m *MsgDepositClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositClaimResponse) ProtoMessage()    {}
 func (*MsgDepositClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{13}
+	return fileDescriptor_75b6627b296db358, []int{17}
 }
 func (m *MsgDepositClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +1123,7 @@ func (m *MsgWithdrawClaim) Reset()         { *m = MsgWithdrawClaim{} }
 func (m *MsgWithdrawClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawClaim) ProtoMessage()    {}
 func (*MsgWithdrawClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{14}
+	return fileDescriptor_75b6627b296db358, []int{18}
 }
 func (m *MsgWithdrawClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1194,7 @@ func (m *MsgWithdrawClaimResponse) Reset()         { *m = MsgWithdrawClaimRespon
 func (m *MsgWithdrawClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawClaimResponse) ProtoMessage()    {}
 func (*MsgWithdrawClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{15}
+	return fileDescriptor_75b6627b296db358, []int{19}
 }
 func (m *MsgWithdrawClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1241,7 @@ func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
 func (m *MsgERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaim) ProtoMessage()    {}
 func (*MsgERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{16}
+	return fileDescriptor_75b6627b296db358, []int{20}
 }
 func (m *MsgERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1333,7 @@ func (m *MsgERC20DeployedClaimResponse) Reset()         { *m = MsgERC20DeployedC
 func (m *MsgERC20DeployedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaimResponse) ProtoMessage()    {}
 func (*MsgERC20DeployedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{17}
+	return fileDescriptor_75b6627b296db358, []int{21}
 }
 func (m *MsgERC20DeployedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1376,7 @@ func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedC
 func (m *MsgLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaim) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{18}
+	return fileDescriptor_75b6627b296db358, []int{22}
 }
 func (m *MsgLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1447,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Reset()         { *m = MsgLogicCallE
 func (m *MsgLogicCallExecutedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaimResponse) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75b6627b296db358, []int{19}
+	return fileDescriptor_75b6627b296db358, []int{23}
 }
 func (m *MsgLogicCallExecutedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,133 +1476,276 @@ func (m *MsgLogicCallExecutedClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLogicCallExecutedClaimResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "peggy.v1.MsgSetOrchestratorAddress")
-	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "peggy.v1.MsgSetOrchestratorAddressResponse")
-	proto.RegisterType((*MsgValsetConfirm)(nil), "peggy.v1.MsgValsetConfirm")
-	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "peggy.v1.MsgValsetConfirmResponse")
-	proto.RegisterType((*MsgSendToEth)(nil), "peggy.v1.MsgSendToEth")
-	proto.RegisterType((*MsgSendToEthResponse)(nil), "peggy.v1.MsgSendToEthResponse")
-	proto.RegisterType((*MsgRequestBatch)(nil), "peggy.v1.MsgRequestBatch")
-	proto.RegisterType((*MsgRequestBatchResponse)(nil), "peggy.v1.MsgRequestBatchResponse")
-	proto.RegisterType((*MsgConfirmBatch)(nil), "peggy.v1.MsgConfirmBatch")
-	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "peggy.v1.MsgConfirmBatchResponse")
-	proto.RegisterType((*MsgConfirmLogicCall)(nil), "peggy.v1.MsgConfirmLogicCall")
-	proto.RegisterType((*MsgConfirmLogicCallResponse)(nil), "peggy.v1.MsgConfirmLogicCallResponse")
-	proto.RegisterType((*MsgDepositClaim)(nil), "peggy.v1.MsgDepositClaim")
-	proto.RegisterType((*MsgDepositClaimResponse)(nil), "peggy.v1.MsgDepositClaimResponse")
-	proto.RegisterType((*MsgWithdrawClaim)(nil), "peggy.v1.MsgWithdrawClaim")
-	proto.RegisterType((*MsgWithdrawClaimResponse)(nil), "peggy.v1.MsgWithdrawClaimResponse")
-	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "peggy.v1.MsgERC20DeployedClaim")
-	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "peggy.v1.MsgERC20DeployedClaimResponse")
-	proto.RegisterType((*MsgLogicCallExecutedClaim)(nil), "peggy.v1.MsgLogicCallExecutedClaim")
-	proto.RegisterType((*MsgLogicCallExecutedClaimResponse)(nil), "peggy.v1.MsgLogicCallExecutedClaimResponse")
+// ERC20MetadataClaim allows the Cosmos module
+// to learn the name, symbol and decimals of an
+// Ethereum originated ERC20 read from its contract.
+// It is attested by token contract, outside of the
+// event nonces of the peggy contract
+type MsgERC20MetadataClaim struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals      uint64 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Orchestrator  string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 }
 
-func init() { proto.RegisterFile("peggy/v1/msgs.proto", fileDescriptor_75b6627b296db358) }
-
-var fileDescriptor_75b6627b296db358 = []byte{
-	// 1209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0xdb, 0xb4, 0xdb, 0xbc, 0x66, 0xdb, 0xc5, 0xdb, 0x6d, 0x13, 0xb3, 0x4d, 0x5a, 0x97,
-	0x6e, 0x11, 0xab, 0xc6, 0x6d, 0x39, 0x70, 0x43, 0xa2, 0x69, 0x57, 0x2c, 0xd0, 0x45, 0x4a, 0x11,
-	0x48, 0x5c, 0x2c, 0xc7, 0x7e, 0x6b, 0x5b, 0xb5, 0x3d, 0xa9, 0x67, 0x92, 0x6d, 0x2f, 0x1c, 0x38,
-	0x70, 0xe1, 0x02, 0x42, 0x82, 0x3f, 0x83, 0x3b, 0x47, 0x4e, 0x7b, 0x42, 0x2b, 0xb8, 0x20, 0x90,
-	0x56, 0xa8, 0xe5, 0x0f, 0x41, 0x9e, 0x99, 0x38, 0x76, 0xe2, 0xb0, 0x45, 0x2a, 0xa7, 0xc4, 0x6f,
-	0x9e, 0xe7, 0x7b, 0xdf, 0xf7, 0x7e, 0xcc, 0x18, 0xee, 0x76, 0xd1, 0x75, 0x2f, 0x8c, 0xfe, 0x9e,
-	0x11, 0x52, 0x97, 0x36, 0xbb, 0x31, 0x61, 0x44, 0x9d, 0xe7, 0xc6, 0x66, 0x7f, 0x4f, 0xab, 0xdb,
-	0x84, 0x86, 0x84, 0x1a, 0x1d, 0x8b, 0xa2, 0xd1, 0xdf, 0xeb, 0x20, 0xb3, 0xf6, 0x0c, 0x9b, 0xf8,
-	0x91, 0xf0, 0xd4, 0x96, 0x5d, 0xe2, 0x12, 0xfe, 0xd7, 0x48, 0xfe, 0x49, 0xeb, 0x7d, 0x97, 0x10,
-	0x37, 0x40, 0xc3, 0xea, 0xfa, 0x86, 0x15, 0x45, 0x84, 0x59, 0xcc, 0x27, 0x91, 0xdc, 0x5d, 0xff,
-	0x02, 0x6a, 0xc7, 0xd4, 0x3d, 0x41, 0xf6, 0x71, 0x6c, 0x7b, 0x48, 0x59, 0x6c, 0x31, 0x12, 0xbf,
-	0xe7, 0x38, 0x31, 0x52, 0xaa, 0xde, 0x87, 0x72, 0xdf, 0x0a, 0x7c, 0x27, 0xb1, 0x55, 0x95, 0x75,
-	0xe5, 0xcd, 0x72, 0x7b, 0x68, 0x50, 0x75, 0xa8, 0x90, 0xcc, 0x4b, 0xd5, 0x69, 0xee, 0x90, 0xb3,
-	0xa9, 0x0d, 0x58, 0x40, 0xe6, 0x99, 0x96, 0xd8, 0xb0, 0x3a, 0xc3, 0x5d, 0x00, 0x99, 0x27, 0x21,
-	0xf4, 0x4d, 0xd8, 0x98, 0x88, 0xdf, 0x46, 0xda, 0x25, 0x11, 0x45, 0xfd, 0x6b, 0x05, 0xee, 0x1c,
-	0x53, 0xf7, 0x53, 0x2b, 0xa0, 0xc8, 0x5a, 0x24, 0x7a, 0xea, 0xc7, 0xa1, 0xba, 0x0c, 0xb3, 0x11,
-	0x89, 0x6c, 0xe4, 0x81, 0x95, 0xda, 0xe2, 0xe1, 0x46, 0x82, 0x4a, 0x78, 0x53, 0xdf, 0x8d, 0x2c,
-	0xd6, 0x8b, 0xb1, 0x5a, 0x12, 0xbc, 0x53, 0x83, 0xae, 0x41, 0x75, 0x34, 0x98, 0x34, 0xd2, 0x9f,
-	0x14, 0xa8, 0x70, 0x3e, 0x91, 0xf3, 0x09, 0x39, 0x62, 0x9e, 0xba, 0x02, 0x73, 0x14, 0x23, 0x07,
-	0x07, 0xfa, 0xc9, 0x27, 0xb5, 0x06, 0xf3, 0x49, 0x0c, 0x0e, 0x52, 0x26, 0x63, 0xbc, 0x85, 0xcc,
-	0x3b, 0x44, 0xca, 0xd4, 0x77, 0x60, 0xce, 0x0a, 0x49, 0x2f, 0x62, 0x3c, 0xb2, 0x85, 0xfd, 0x5a,
-	0x53, 0xe4, 0xbd, 0x99, 0xe4, 0xbd, 0x29, 0xf3, 0xde, 0x6c, 0x11, 0x3f, 0x3a, 0x28, 0x3d, 0x7f,
-	0xd9, 0x98, 0x6a, 0x4b, 0x77, 0xf5, 0x5d, 0x80, 0x4e, 0xec, 0x3b, 0x2e, 0x9a, 0x4f, 0x51, 0xc4,
-	0x7d, 0x8d, 0x97, 0xcb, 0xe2, 0x95, 0x47, 0x88, 0xfa, 0x0a, 0x2c, 0x67, 0x63, 0x4f, 0x49, 0x7d,
-	0x08, 0x4b, 0xc7, 0xd4, 0x6d, 0xe3, 0x59, 0x0f, 0x29, 0x3b, 0xb0, 0x98, 0xed, 0x8d, 0xc9, 0xac,
-	0x14, 0xc8, 0xbc, 0x0c, 0xb3, 0x0e, 0x46, 0x24, 0x94, 0xfc, 0xc4, 0x83, 0x5e, 0x83, 0xd5, 0x91,
-	0xcd, 0x52, 0x9c, 0x1f, 0x15, 0x0e, 0x24, 0x35, 0x15, 0x40, 0xc5, 0x59, 0xde, 0x82, 0x45, 0x46,
-	0x4e, 0x31, 0x32, 0x6d, 0x12, 0xb1, 0xd8, 0xb2, 0x07, 0x1a, 0xde, 0xe6, 0xd6, 0x96, 0x34, 0xaa,
-	0x6b, 0x90, 0x64, 0xd5, 0x4c, 0x52, 0x87, 0xb1, 0xcc, 0x73, 0x19, 0x99, 0x77, 0xc2, 0x0d, 0x63,
-	0x24, 0x4a, 0x05, 0x24, 0x72, 0xa5, 0x30, 0x3b, 0x5a, 0x0a, 0x82, 0x4c, 0x36, 0xe0, 0x94, 0xcc,
-	0x2f, 0x0a, 0xdc, 0x1d, 0xae, 0x7d, 0x44, 0x5c, 0xdf, 0x6e, 0x59, 0x41, 0xa0, 0x6e, 0xc3, 0x92,
-	0x1f, 0xc9, 0x26, 0xf2, 0x49, 0x64, 0xfa, 0x0e, 0xa7, 0x56, 0x69, 0x2f, 0x66, 0xcd, 0x8f, 0x1d,
-	0x75, 0x07, 0xd4, 0x9c, 0xa3, 0x90, 0x61, 0x9a, 0xcb, 0xf0, 0x5a, 0x76, 0xe5, 0x09, 0x97, 0xe4,
-	0x7f, 0xe7, 0xba, 0x06, 0xaf, 0x17, 0xf0, 0x19, 0x56, 0xfe, 0x34, 0x4f, 0xde, 0x21, 0x76, 0x09,
-	0xf5, 0x59, 0x2b, 0xb0, 0xfc, 0x90, 0x37, 0x5a, 0x1f, 0x23, 0x66, 0x66, 0x53, 0x08, 0xdc, 0x24,
-	0x82, 0xde, 0x80, 0x4a, 0x27, 0x20, 0xf6, 0xa9, 0xe9, 0xa1, 0xef, 0x7a, 0x4c, 0xb2, 0x5b, 0xe0,
-	0xb6, 0xf7, 0xb9, 0xa9, 0x20, 0xd5, 0x33, 0x45, 0xa9, 0x7e, 0x94, 0x36, 0x0d, 0x67, 0x76, 0xd0,
-	0x4c, 0x8a, 0xfb, 0x8f, 0x97, 0x8d, 0x07, 0xae, 0xcf, 0xbc, 0x5e, 0xa7, 0x69, 0x93, 0xd0, 0x90,
-	0xe3, 0x53, 0xfc, 0xec, 0x50, 0xe7, 0xd4, 0x60, 0x17, 0x5d, 0xa4, 0xcd, 0xc7, 0x11, 0x4b, 0x7b,
-	0x68, 0x1b, 0x96, 0x90, 0x79, 0x18, 0x63, 0x2f, 0x34, 0x65, 0xe3, 0x0a, 0x25, 0x16, 0x07, 0xe6,
-	0x13, 0xd1, 0xc0, 0xdb, 0xb0, 0x24, 0x36, 0x32, 0x63, 0xb4, 0xd1, 0xef, 0x63, 0x5c, 0x9d, 0x13,
-	0x8e, 0xc2, 0xdc, 0x96, 0xd6, 0x31, 0xe5, 0x6f, 0x8d, 0x2b, 0x2f, 0xeb, 0x28, 0xab, 0x5d, 0xaa,
-	0xeb, 0xcf, 0x62, 0xf6, 0x7d, 0xe6, 0x33, 0xcf, 0x89, 0xad, 0x67, 0x37, 0x27, 0x6c, 0x03, 0x16,
-	0x3a, 0x49, 0xc5, 0xca, 0x3d, 0x66, 0xc4, 0x1e, 0xdc, 0xf4, 0x64, 0x42, 0x93, 0x95, 0x8a, 0x94,
-	0x1f, 0xe5, 0x37, 0x5b, 0xc0, 0x4f, 0x8c, 0xcc, 0x1c, 0x87, 0x94, 0xe0, 0xb7, 0xd3, 0x70, 0xef,
-	0x98, 0xba, 0x47, 0xed, 0xd6, 0xfe, 0xee, 0x21, 0x76, 0x03, 0x72, 0x81, 0xce, 0xcd, 0xb1, 0xdc,
-	0x80, 0x8a, 0x4c, 0x93, 0x98, 0x45, 0xa2, 0x78, 0x16, 0x84, 0xed, 0x30, 0x31, 0x5d, 0x97, 0xa7,
-	0x0a, 0xa5, 0xc8, 0x0a, 0x07, 0x8d, 0xc1, 0xff, 0xf3, 0xe9, 0x7e, 0x11, 0x76, 0x48, 0x20, 0x73,
-	0x2f, 0x9f, 0x54, 0x0d, 0xe6, 0x1d, 0xb4, 0xfd, 0xd0, 0x0a, 0x28, 0xcf, 0x77, 0xa9, 0x9d, 0x3e,
-	0x8f, 0xe9, 0x35, 0x5f, 0xa0, 0x57, 0x03, 0xd6, 0x0a, 0x25, 0x49, 0x45, 0xfb, 0x53, 0xe1, 0xe7,
-	0x76, 0xda, 0x86, 0x47, 0xe7, 0x68, 0xf7, 0xd8, 0x4d, 0x0a, 0x57, 0x30, 0xa7, 0x66, 0xfe, 0xc3,
-	0x9c, 0x2a, 0x4d, 0x9a, 0x53, 0xd7, 0x29, 0x17, 0x71, 0x29, 0x28, 0x26, 0x37, 0x90, 0x60, 0xff,
-	0xd7, 0x32, 0xcc, 0x1c, 0x53, 0x57, 0x3d, 0x83, 0xdb, 0xf9, 0x8b, 0x81, 0xd6, 0x1c, 0xdc, 0x98,
-	0x9a, 0xa3, 0xe7, 0xb4, 0xa6, 0x4f, 0x5e, 0x4b, 0xb5, 0x5d, 0xff, 0xf2, 0xb7, 0xbf, 0xbf, 0x9b,
-	0xd6, 0xf4, 0xaa, 0x91, 0x5e, 0xc7, 0xfa, 0xdc, 0x31, 0x29, 0x10, 0x8e, 0xd0, 0x81, 0x72, 0xe6,
-	0x84, 0xcf, 0x6d, 0x99, 0xda, 0xb5, 0x7a, 0xb1, 0x3d, 0x85, 0x59, 0xe3, 0x30, 0xab, 0xfa, 0xbd,
-	0x21, 0x4c, 0x32, 0x70, 0x4c, 0x46, 0x4c, 0x64, 0x9e, 0x1a, 0x42, 0x25, 0x77, 0xe2, 0xd6, 0x72,
-	0xdb, 0x65, 0x97, 0xb4, 0x8d, 0x89, 0x4b, 0x29, 0x58, 0x83, 0x83, 0xd5, 0xf4, 0xd5, 0x21, 0x58,
-	0x2c, 0xfc, 0x4c, 0xde, 0xf1, 0x09, 0x5c, 0xee, 0xdc, 0xcd, 0xc3, 0x65, 0x97, 0x46, 0xe0, 0x0a,
-	0x0f, 0xbf, 0x02, 0x38, 0xa9, 0x9d, 0x84, 0x3b, 0x87, 0x3b, 0x63, 0x27, 0xe3, 0x5a, 0xd1, 0xbe,
-	0xe9, 0xb2, 0xb6, 0xf5, 0xaf, 0xcb, 0x29, 0x74, 0x9d, 0x43, 0x57, 0xf5, 0x95, 0x11, 0xe8, 0xd0,
-	0x0c, 0x12, 0xdf, 0x84, 0x68, 0xee, 0x8c, 0xca, 0x13, 0xcd, 0x2e, 0x8d, 0x10, 0x2d, 0x9c, 0xce,
-	0x05, 0x44, 0x1d, 0xe1, 0x67, 0xda, 0x7c, 0xfb, 0x33, 0xb8, 0x9d, 0x1f, 0xdd, 0xf9, 0xea, 0xcc,
-	0xad, 0x8d, 0x54, 0x67, 0xf1, 0xb8, 0x2c, 0xa8, 0xce, 0x67, 0xd2, 0x51, 0x42, 0x7e, 0xa5, 0x80,
-	0x5a, 0x34, 0x4d, 0x73, 0x9b, 0x8f, 0x3b, 0x68, 0xdb, 0xaf, 0x70, 0x48, 0x43, 0x78, 0xc0, 0x43,
-	0x58, 0xd7, 0xeb, 0xc3, 0x10, 0x30, 0xb6, 0xf7, 0x77, 0x4d, 0x47, 0xba, 0xcb, 0x40, 0x7e, 0x50,
-	0x60, 0x65, 0xc2, 0x84, 0xda, 0xcc, 0x61, 0x15, 0x3b, 0x69, 0x0f, 0xaf, 0xe1, 0x94, 0x06, 0xf5,
-	0x90, 0x07, 0xb5, 0xa5, 0x6f, 0x0e, 0x83, 0xe2, 0x09, 0x37, 0x6d, 0x2b, 0x08, 0x4c, 0x94, 0xef,
-	0xc8, 0xc8, 0xbe, 0x57, 0x60, 0x65, 0xc2, 0x37, 0xcf, 0xe6, 0x48, 0xdb, 0x16, 0x39, 0x8d, 0x44,
-	0xf6, 0x8a, 0xaf, 0x97, 0xb7, 0x78, 0x64, 0x6f, 0xe8, 0x7a, 0xb6, 0xd1, 0x99, 0x99, 0x9d, 0x78,
-	0x83, 0x6f, 0x91, 0x83, 0x0f, 0x9e, 0x5f, 0xd6, 0x95, 0x17, 0x97, 0x75, 0xe5, 0xaf, 0xcb, 0xba,
-	0xf2, 0xcd, 0x55, 0x7d, 0xea, 0xc5, 0x55, 0x7d, 0xea, 0xf7, 0xab, 0xfa, 0xd4, 0xe7, 0xbb, 0x99,
-	0x8b, 0x8c, 0x15, 0x30, 0x0f, 0xad, 0x9d, 0x08, 0x99, 0xdc, 0x32, 0x24, 0x4e, 0x2f, 0x40, 0xe3,
-	0x5c, 0x3e, 0xf2, 0x6b, 0x4d, 0x67, 0x8e, 0x7f, 0xe1, 0xbd, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xff, 0x2e, 0x48, 0xa1, 0x56, 0x0e, 0x00, 0x00,
+func (m *MsgERC20MetadataClaim) Reset()         { *m = MsgERC20MetadataClaim{} }
+func (m *MsgERC20MetadataClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgERC20MetadataClaim) ProtoMessage()    {}
+func (*MsgERC20MetadataClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b6627b296db358, []int{24}
+}
+func (m *MsgERC20MetadataClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgERC20MetadataClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgERC20MetadataClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgERC20MetadataClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgERC20MetadataClaim.Merge(m, src)
+}
+func (m *MsgERC20MetadataClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgERC20MetadataClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgERC20MetadataClaim.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgERC20MetadataClaim proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgERC20MetadataClaim) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
-	SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error)
-	RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error)
-	ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error)
-	ConfirmLogicCall(ctx context.Context, in *MsgConfirmLogicCall, opts ...grpc.CallOption) (*MsgConfirmLogicCallResponse, error)
-	DepositClaim(ctx context.Context, in *MsgDepositClaim, opts ...grpc.CallOption) (*MsgDepositClaimResponse, error)
-	WithdrawClaim(ctx context.Context, in *MsgWithdrawClaim, opts ...grpc.CallOption) (*MsgWithdrawClaimResponse, error)
-	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
-	LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCallExecutedClaim, opts ...grpc.CallOption) (*MsgLogicCallExecutedClaimResponse, error)
-	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
+func (m *MsgERC20MetadataClaim) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgERC20MetadataClaim) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgERC20MetadataClaim) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *MsgERC20MetadataClaim) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+type MsgERC20MetadataClaimResponse struct {
+}
+
+func (m *MsgERC20MetadataClaimResponse) Reset()         { *m = MsgERC20MetadataClaimResponse{} }
+func (m *MsgERC20MetadataClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgERC20MetadataClaimResponse) ProtoMessage()    {}
+func (*MsgERC20MetadataClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b6627b296db358, []int{25}
+}
+func (m *MsgERC20MetadataClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgERC20MetadataClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgERC20MetadataClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgERC20MetadataClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgERC20MetadataClaimResponse.Merge(m, src)
+}
+func (m *MsgERC20MetadataClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgERC20MetadataClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgERC20MetadataClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgERC20MetadataClaimResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "peggy.v1.MsgSetOrchestratorAddress")
+	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "peggy.v1.MsgSetOrchestratorAddressResponse")
+	proto.RegisterType((*MsgRotateDelegateKeys)(nil), "peggy.v1.MsgRotateDelegateKeys")
+	proto.RegisterType((*MsgRotateDelegateKeysResponse)(nil), "peggy.v1.MsgRotateDelegateKeysResponse")
+	proto.RegisterType((*MsgSweepFallbackAccount)(nil), "peggy.v1.MsgSweepFallbackAccount")
+	proto.RegisterType((*MsgSweepFallbackAccountResponse)(nil), "peggy.v1.MsgSweepFallbackAccountResponse")
+	proto.RegisterType((*MsgValsetConfirm)(nil), "peggy.v1.MsgValsetConfirm")
+	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "peggy.v1.MsgValsetConfirmResponse")
+	proto.RegisterType((*MsgSendToEth)(nil), "peggy.v1.MsgSendToEth")
+	proto.RegisterType((*MsgSendToEthResponse)(nil), "peggy.v1.MsgSendToEthResponse")
+	proto.RegisterType((*MsgRequestBatch)(nil), "peggy.v1.MsgRequestBatch")
+	proto.RegisterType((*MsgRequestBatchResponse)(nil), "peggy.v1.MsgRequestBatchResponse")
+	proto.RegisterType((*MsgConfirmBatch)(nil), "peggy.v1.MsgConfirmBatch")
+	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "peggy.v1.MsgConfirmBatchResponse")
+	proto.RegisterType((*MsgConfirmLogicCall)(nil), "peggy.v1.MsgConfirmLogicCall")
+	proto.RegisterType((*MsgConfirmLogicCallResponse)(nil), "peggy.v1.MsgConfirmLogicCallResponse")
+	proto.RegisterType((*MsgDepositClaim)(nil), "peggy.v1.MsgDepositClaim")
+	proto.RegisterType((*MsgDepositClaimResponse)(nil), "peggy.v1.MsgDepositClaimResponse")
+	proto.RegisterType((*MsgWithdrawClaim)(nil), "peggy.v1.MsgWithdrawClaim")
+	proto.RegisterType((*MsgWithdrawClaimResponse)(nil), "peggy.v1.MsgWithdrawClaimResponse")
+	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "peggy.v1.MsgERC20DeployedClaim")
+	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "peggy.v1.MsgERC20DeployedClaimResponse")
+	proto.RegisterType((*MsgLogicCallExecutedClaim)(nil), "peggy.v1.MsgLogicCallExecutedClaim")
+	proto.RegisterType((*MsgLogicCallExecutedClaimResponse)(nil), "peggy.v1.MsgLogicCallExecutedClaimResponse")
+	proto.RegisterType((*MsgERC20MetadataClaim)(nil), "peggy.v1.MsgERC20MetadataClaim")
+	proto.RegisterType((*MsgERC20MetadataClaimResponse)(nil), "peggy.v1.MsgERC20MetadataClaimResponse")
+}
+
+func init() { proto.RegisterFile("peggy/v1/msgs.proto", fileDescriptor_75b6627b296db358) }
+
+var fileDescriptor_75b6627b296db358 = []byte{
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xc1, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8e, 0xb3, 0xbb, 0x69, 0x32, 0xd9, 0x34, 0xfd, 0xb9, 0x69, 0xb2, 0xf1, 0xaf, 0xd9, 0x4d,
+	0x1c, 0xda, 0xa4, 0x44, 0xdd, 0x4d, 0xc2, 0x81, 0x1b, 0x52, 0x93, 0xb4, 0xa2, 0x2d, 0x29, 0xd2,
+	0x16, 0x81, 0xc4, 0xc5, 0x9a, 0xb5, 0x5f, 0x6c, 0x2b, 0xb6, 0x67, 0x6b, 0xcf, 0x6e, 0x93, 0x2b,
+	0x87, 0x5e, 0x90, 0x10, 0x08, 0x09, 0x8e, 0x08, 0x71, 0xeb, 0x85, 0x3b, 0x47, 0x4e, 0x3d, 0xa1,
+	0x4a, 0x5c, 0x10, 0x48, 0x05, 0x35, 0xfc, 0x0b, 0xdc, 0x91, 0x67, 0xc6, 0xb3, 0xf6, 0xae, 0x77,
+	0xb3, 0x48, 0x41, 0xe2, 0x94, 0xf8, 0xcd, 0x37, 0xef, 0xbd, 0xef, 0x7b, 0x6f, 0x9e, 0x67, 0x8d,
+	0xae, 0xb6, 0xc1, 0xb6, 0x4f, 0x1b, 0xdd, 0x9d, 0x86, 0x1f, 0xd9, 0x51, 0xbd, 0x1d, 0x12, 0x4a,
+	0xd4, 0x69, 0x66, 0xac, 0x77, 0x77, 0xb4, 0xaa, 0x49, 0x22, 0x9f, 0x44, 0x8d, 0x16, 0x8e, 0xa0,
+	0xd1, 0xdd, 0x69, 0x01, 0xc5, 0x3b, 0x0d, 0x93, 0xb8, 0x01, 0x47, 0x6a, 0x0b, 0x36, 0xb1, 0x09,
+	0xfb, 0xb7, 0x11, 0xff, 0x27, 0xac, 0xd7, 0x6d, 0x42, 0x6c, 0x0f, 0x1a, 0xb8, 0xed, 0x36, 0x70,
+	0x10, 0x10, 0x8a, 0xa9, 0x4b, 0x02, 0xe1, 0x5d, 0xff, 0x4e, 0x41, 0xcb, 0x87, 0x91, 0xfd, 0x18,
+	0xe8, 0xfb, 0xa1, 0xe9, 0x40, 0x44, 0x43, 0x4c, 0x49, 0x78, 0xc7, 0xb2, 0x42, 0x88, 0x22, 0xf5,
+	0x3a, 0x9a, 0xe9, 0x62, 0xcf, 0xb5, 0x62, 0x5b, 0x45, 0x59, 0x55, 0x36, 0x67, 0x9a, 0x3d, 0x83,
+	0xaa, 0xa3, 0x32, 0x49, 0x6d, 0xaa, 0x4c, 0x32, 0x40, 0xc6, 0xa6, 0xd6, 0xd0, 0x2c, 0x50, 0xc7,
+	0xc0, 0xdc, 0x61, 0xa5, 0xc0, 0x20, 0x08, 0xa8, 0x93, 0x84, 0x58, 0x47, 0x73, 0x31, 0x20, 0x72,
+	0xed, 0x00, 0xd3, 0x4e, 0x08, 0x95, 0x22, 0xf7, 0x02, 0xd4, 0x79, 0x9c, 0xd8, 0xf4, 0x75, 0xb4,
+	0x36, 0x34, 0xc9, 0x26, 0x44, 0x6d, 0x12, 0x44, 0xa0, 0x7f, 0xa3, 0xa0, 0x6b, 0x87, 0x91, 0xdd,
+	0x8c, 0x19, 0xc2, 0x01, 0x78, 0x60, 0x63, 0x0a, 0x0f, 0xe1, 0xf4, 0xbf, 0x43, 0xe3, 0x01, 0x5a,
+	0xc9, 0x4d, 0x30, 0xa1, 0xa0, 0xde, 0x42, 0x57, 0xe0, 0xe8, 0x08, 0x4c, 0xea, 0x76, 0xc1, 0x70,
+	0xc0, 0xb5, 0x1d, 0xca, 0xf2, 0x2d, 0x36, 0xe7, 0xa5, 0xfd, 0x5d, 0x66, 0xd6, 0xbf, 0x55, 0xd0,
+	0x52, 0xac, 0xc9, 0x53, 0x80, 0xf6, 0x3d, 0xec, 0x79, 0x2d, 0x6c, 0x1e, 0xdf, 0x31, 0x4d, 0xd2,
+	0x09, 0xa8, 0xba, 0x81, 0xe6, 0x81, 0x3a, 0x10, 0x42, 0xc7, 0x37, 0x22, 0x08, 0x2c, 0x48, 0x58,
+	0x5f, 0x4e, 0xcc, 0x8f, 0x99, 0x55, 0xd5, 0xd0, 0x74, 0x08, 0x26, 0xb8, 0x5d, 0x48, 0x68, 0xcb,
+	0xe7, 0x41, 0x46, 0x85, 0x41, 0x46, 0xb1, 0xb2, 0x51, 0xa7, 0xe5, 0xbb, 0x94, 0x42, 0x28, 0x28,
+	0xf7, 0x0c, 0xfa, 0x33, 0x05, 0xd5, 0x86, 0xe4, 0x28, 0x29, 0x9b, 0x68, 0x0a, 0xfb, 0xb1, 0xa5,
+	0xa2, 0xac, 0x16, 0x36, 0x67, 0x77, 0x97, 0xeb, 0xbc, 0xcb, 0xeb, 0x71, 0x97, 0xd7, 0x45, 0x97,
+	0xd7, 0xf7, 0x89, 0x1b, 0xec, 0x6d, 0xbf, 0x78, 0x55, 0x9b, 0x78, 0xfe, 0x7b, 0x6d, 0xd3, 0x76,
+	0xa9, 0xd3, 0x69, 0xd5, 0x4d, 0xe2, 0x37, 0xc4, 0x91, 0xe0, 0x7f, 0x6e, 0x47, 0xd6, 0x71, 0x83,
+	0x9e, 0xb6, 0x21, 0x62, 0x1b, 0xa2, 0xa6, 0x70, 0xad, 0x7f, 0xaa, 0xa0, 0x2b, 0x87, 0x91, 0xfd,
+	0x21, 0xf6, 0x22, 0xa0, 0xfb, 0x24, 0x38, 0x72, 0x43, 0x5f, 0x5d, 0x40, 0xa5, 0x80, 0x04, 0x26,
+	0x08, 0x85, 0xf9, 0xc3, 0xc5, 0x74, 0x43, 0x2c, 0x4b, 0x5f, 0x27, 0xf4, 0x0c, 0xba, 0x86, 0x2a,
+	0xfd, 0xc9, 0xc8, 0x26, 0xfe, 0x41, 0x41, 0x65, 0xd6, 0xea, 0x81, 0xf5, 0x01, 0xb9, 0x4b, 0x1d,
+	0x75, 0x11, 0x4d, 0x65, 0x4a, 0x28, 0x9e, 0xd4, 0x65, 0x34, 0x1d, 0xe7, 0x60, 0x41, 0x44, 0x45,
+	0x8e, 0x97, 0x80, 0x3a, 0x07, 0x10, 0x51, 0xf5, 0x6d, 0x29, 0x69, 0x9c, 0xd9, 0x48, 0x49, 0x8b,
+	0xb1, 0xa4, 0x89, 0x4c, 0xea, 0x3b, 0x08, 0xb5, 0x42, 0xd7, 0xb2, 0xc1, 0x38, 0x02, 0x9e, 0xf7,
+	0x18, 0x9b, 0x67, 0xf8, 0x96, 0x7b, 0x00, 0xfa, 0x16, 0x5a, 0x48, 0xe7, 0x2e, 0x6b, 0x7c, 0x15,
+	0x95, 0xe8, 0x89, 0xe1, 0x5a, 0x42, 0xe9, 0x22, 0x3d, 0xb9, 0x6f, 0xe9, 0x0f, 0xd1, 0x7c, 0x7c,
+	0x18, 0xe0, 0x49, 0x07, 0x22, 0xba, 0x87, 0xa9, 0xe9, 0x0c, 0x68, 0xaf, 0xe4, 0x68, 0xbf, 0x80,
+	0x4a, 0x16, 0x04, 0xc4, 0x17, 0xa4, 0xf9, 0x83, 0xbe, 0x8c, 0x96, 0xfa, 0x9c, 0x49, 0x45, 0xbf,
+	0x57, 0x58, 0x20, 0x21, 0x34, 0x0f, 0x94, 0x5f, 0xfa, 0x1b, 0xe8, 0x32, 0x25, 0xc7, 0x10, 0x18,
+	0x26, 0x09, 0x68, 0x88, 0xcd, 0x44, 0xd8, 0x39, 0x66, 0xdd, 0x17, 0x46, 0x75, 0x05, 0xa1, 0xe4,
+	0x60, 0x40, 0x28, 0x8a, 0x3f, 0x23, 0x4e, 0x05, 0x0c, 0x8e, 0x93, 0x62, 0x0e, 0x89, 0x4c, 0x7f,
+	0x94, 0xfa, 0xfb, 0x83, 0x93, 0x49, 0x27, 0x2c, 0xc9, 0xfc, 0xa4, 0xa0, 0xab, 0xbd, 0xb5, 0xf7,
+	0x88, 0xed, 0x9a, 0xfb, 0xd8, 0xf3, 0xe2, 0x13, 0xef, 0x06, 0x62, 0xa4, 0xb9, 0x24, 0x48, 0xb4,
+	0x2e, 0x37, 0x2f, 0xa7, 0xcd, 0xf7, 0x2d, 0xf5, 0x36, 0x52, 0x33, 0x40, 0x2e, 0xc3, 0x24, 0x93,
+	0xe1, 0x7f, 0xe9, 0x95, 0x47, 0x4c, 0x92, 0x7f, 0x9d, 0xeb, 0x0a, 0xfa, 0x7f, 0x0e, 0x9f, 0xde,
+	0x71, 0x98, 0x64, 0xc5, 0x3b, 0x80, 0x36, 0x89, 0x5c, 0xba, 0xef, 0x61, 0xd7, 0x67, 0xa7, 0xaf,
+	0x0b, 0x01, 0x35, 0xd2, 0x25, 0x44, 0xcc, 0xc4, 0x93, 0x5e, 0x43, 0xe5, 0x96, 0x47, 0xcc, 0xe3,
+	0x64, 0x82, 0x72, 0x76, 0xb3, 0xcc, 0xc6, 0xa7, 0x67, 0x4e, 0xa9, 0x0b, 0x79, 0xa5, 0xbe, 0x27,
+	0x4f, 0x12, 0x63, 0xb6, 0x57, 0x8f, 0x3b, 0xfe, 0xd7, 0x57, 0xb5, 0x9b, 0x63, 0x4c, 0xa0, 0xfb,
+	0x01, 0x95, 0x07, 0x2b, 0x67, 0x20, 0x97, 0x72, 0x07, 0xf2, 0x06, 0x9a, 0xe7, 0x8e, 0x0c, 0x39,
+	0x97, 0xa7, 0x38, 0x90, 0x9b, 0x9b, 0xc2, 0x3a, 0xa0, 0xfc, 0xa5, 0x41, 0xe5, 0x45, 0x1f, 0xa5,
+	0xb5, 0x93, 0xba, 0xfe, 0xc8, 0x07, 0xe2, 0x47, 0x2e, 0x75, 0xac, 0x10, 0x3f, 0xbd, 0x38, 0x61,
+	0x6b, 0x68, 0xb6, 0x15, 0x77, 0xac, 0xf0, 0x51, 0xe0, 0x3e, 0x98, 0xe9, 0xd1, 0x90, 0x43, 0x56,
+	0xcc, 0x53, 0xbe, 0x9f, 0x5f, 0x29, 0x87, 0x1f, 0x9f, 0xa3, 0x19, 0x0e, 0x92, 0xe0, 0x17, 0x93,
+	0xec, 0x32, 0x70, 0xb7, 0xb9, 0xbf, 0xbb, 0x7d, 0x00, 0x6d, 0x8f, 0x9c, 0x82, 0x75, 0x71, 0x2c,
+	0xd7, 0x50, 0x59, 0x94, 0x89, 0xcf, 0x22, 0xde, 0x3c, 0xb3, 0xdc, 0x76, 0x10, 0x9b, 0xc6, 0xe5,
+	0xa9, 0xa2, 0x62, 0x80, 0xfd, 0xe4, 0x60, 0xb0, 0xff, 0xd9, 0xc8, 0x3f, 0xf5, 0x5b, 0xc4, 0x13,
+	0xb5, 0x17, 0x4f, 0xf1, 0xdb, 0xda, 0x02, 0xd3, 0xf5, 0xb1, 0x17, 0xb1, 0x7a, 0x17, 0x9b, 0xf2,
+	0x79, 0x40, 0xaf, 0xe9, 0x1c, 0xbd, 0x6a, 0x68, 0x25, 0x57, 0x12, 0x29, 0xda, 0x6f, 0xfc, 0x32,
+	0x28, 0x8f, 0xe1, 0xdd, 0x13, 0x30, 0x3b, 0xf4, 0x22, 0x85, 0xcb, 0x99, 0x53, 0x85, 0x7f, 0x30,
+	0xa7, 0x8a, 0xc3, 0xe6, 0xd4, 0x38, 0xed, 0xc2, 0x2f, 0x91, 0xf9, 0xe4, 0xa4, 0x04, 0xcf, 0x95,
+	0x5e, 0xdf, 0x1c, 0x02, 0xc5, 0x16, 0xa6, 0x98, 0xd3, 0x1f, 0x2c, 0xa8, 0x32, 0xaa, 0xa0, 0x93,
+	0xb9, 0x05, 0x2d, 0x0c, 0x2d, 0x68, 0xf1, 0x9c, 0x82, 0x96, 0x46, 0x17, 0x34, 0x93, 0x6b, 0xc2,
+	0x66, 0xf7, 0xaf, 0x32, 0x2a, 0x1c, 0x46, 0xb6, 0xfa, 0x04, 0xcd, 0x65, 0xef, 0x3e, 0x5a, 0x3d,
+	0xf9, 0x55, 0x51, 0xef, 0xbf, 0x8a, 0x68, 0xfa, 0xf0, 0x35, 0x29, 0xd3, 0xea, 0x27, 0x3f, 0xff,
+	0xf9, 0xe5, 0xa4, 0xa6, 0x57, 0x1a, 0xf2, 0x27, 0x4b, 0x97, 0x01, 0x0d, 0x93, 0x23, 0xd5, 0x16,
+	0x9a, 0x49, 0x5d, 0x62, 0x32, 0x2e, 0xa5, 0x5d, 0xab, 0xe6, 0xdb, 0x65, 0x98, 0x15, 0x16, 0x66,
+	0x49, 0xbf, 0xd6, 0x0b, 0x13, 0x8f, 0x4f, 0x83, 0x12, 0x03, 0xa8, 0xa3, 0xfa, 0xa8, 0x9c, 0xb9,
+	0x3f, 0x2c, 0x67, 0xdc, 0xa5, 0x97, 0xb4, 0xb5, 0xa1, 0x4b, 0x32, 0x58, 0x8d, 0x05, 0x5b, 0xd6,
+	0x97, 0x7a, 0xc1, 0x42, 0x8e, 0x33, 0xd8, 0xfc, 0x8a, 0xc3, 0x65, 0x6e, 0x11, 0xd9, 0x70, 0xe9,
+	0x25, 0x6d, 0x6d, 0xe8, 0xd2, 0xa8, 0x70, 0x42, 0x3b, 0x11, 0xee, 0x04, 0x5d, 0x19, 0x78, 0xcf,
+	0xaf, 0xe4, 0xf9, 0x95, 0xcb, 0xda, 0x8d, 0x91, 0xcb, 0x32, 0x74, 0x95, 0x85, 0xae, 0xe8, 0x8b,
+	0x7d, 0xa1, 0x7d, 0xc3, 0x8b, 0xb1, 0x31, 0xd1, 0xcc, 0x1b, 0x37, 0x4b, 0x34, 0xbd, 0xa4, 0xad,
+	0x0d, 0x5d, 0x1a, 0x45, 0xd4, 0xe2, 0x38, 0xc3, 0x64, 0xee, 0x9f, 0xa0, 0xb9, 0xec, 0x8b, 0x28,
+	0xdb, 0x9d, 0x99, 0x35, 0x4d, 0x1f, 0xbe, 0x36, 0xaa, 0x3b, 0x9f, 0x0a, 0xa0, 0x08, 0xf9, 0x4c,
+	0x41, 0x6a, 0xde, 0xbb, 0x21, 0xe3, 0x7c, 0x10, 0xa0, 0x6d, 0x9c, 0x03, 0x90, 0x29, 0xdc, 0x64,
+	0x29, 0xac, 0xea, 0xd5, 0x5e, 0x0a, 0x10, 0x9a, 0xbb, 0xdb, 0x86, 0x25, 0xe0, 0x22, 0x91, 0xaf,
+	0x15, 0xb4, 0x38, 0x64, 0xde, 0xae, 0x67, 0x62, 0xe5, 0x83, 0xb4, 0xad, 0x31, 0x40, 0x32, 0xa9,
+	0x2d, 0x96, 0xd4, 0x0d, 0x7d, 0xbd, 0x97, 0x14, 0x2b, 0xb8, 0x61, 0x62, 0xcf, 0x33, 0x40, 0xec,
+	0xe9, 0x97, 0x28, 0x3b, 0x06, 0x73, 0x24, 0xca, 0x00, 0xb4, 0x8d, 0x73, 0x00, 0xe7, 0x4b, 0xe4,
+	0x0b, 0xb8, 0x48, 0xe4, 0x2b, 0x05, 0x2d, 0x0e, 0xf9, 0x3e, 0xb1, 0xde, 0x37, 0x3f, 0xf2, 0x40,
+	0xda, 0xd6, 0x18, 0x20, 0x99, 0xd4, 0x9b, 0x2c, 0xa9, 0x37, 0x74, 0x3d, 0x3d, 0x71, 0xa8, 0x91,
+	0x1e, 0xbb, 0xc9, 0xef, 0x3e, 0xa6, 0x50, 0xce, 0xd7, 0x86, 0xac, 0x42, 0x83, 0x00, 0x6d, 0xe3,
+	0x1c, 0xc0, 0x28, 0x85, 0x42, 0x86, 0x36, 0x2c, 0x01, 0x37, 0x8e, 0xe3, 0x88, 0x9f, 0x29, 0x68,
+	0x21, 0xf7, 0x43, 0x40, 0xf6, 0x74, 0xe6, 0x41, 0xb4, 0x5b, 0xe7, 0x42, 0x64, 0x3a, 0x9b, 0x2c,
+	0x1d, 0x5d, 0x5f, 0x4d, 0x69, 0x13, 0xe3, 0x8d, 0x23, 0xb1, 0xc1, 0xc0, 0x7c, 0xc7, 0xde, 0x83,
+	0x17, 0xaf, 0xab, 0xca, 0xcb, 0xd7, 0x55, 0xe5, 0x8f, 0xd7, 0x55, 0xe5, 0xf3, 0xb3, 0xea, 0xc4,
+	0xcb, 0xb3, 0xea, 0xc4, 0x2f, 0x67, 0xd5, 0x89, 0x8f, 0xb7, 0x53, 0x37, 0x67, 0xec, 0x51, 0x07,
+	0xf0, 0xed, 0x00, 0xa8, 0x70, 0xe8, 0x13, 0xab, 0xe3, 0x41, 0xe3, 0x44, 0x3c, 0xb2, 0x7b, 0x74,
+	0x6b, 0x8a, 0x7d, 0xa8, 0x7a, 0xeb, 0xef, 0x01, 0x00, 0xe3, 0x50, 0xe1, 0xeb, 0x1d, 0x13, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
+	SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error)
+	RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error)
+	ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error)
+	ConfirmLogicCall(ctx context.Context, in *MsgConfirmLogicCall, opts ...grpc.CallOption) (*MsgConfirmLogicCallResponse, error)
+	DepositClaim(ctx context.Context, in *MsgDepositClaim, opts ...grpc.CallOption) (*MsgDepositClaimResponse, error)
+	WithdrawClaim(ctx context.Context, in *MsgWithdrawClaim, opts ...grpc.CallOption) (*MsgWithdrawClaimResponse, error)
+	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
+	LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCallExecutedClaim, opts ...grpc.CallOption) (*MsgLogicCallExecutedClaimResponse, error)
+	ERC20MetadataClaim(ctx context.Context, in *MsgERC20MetadataClaim, opts ...grpc.CallOption) (*MsgERC20MetadataClaimResponse, error)
+	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
+	RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error)
+	SweepFallbackAccount(ctx context.Context, in *MsgSweepFallbackAccount, opts ...grpc.CallOption) (*MsgSweepFallbackAccountResponse, error)
 }
 
 type msgClient struct {
@@ -1423,6 +1837,15 @@ func (c *msgClient) LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCall
 	return out, nil
 }
 
+func (c *msgClient) ERC20MetadataClaim(ctx context.Context, in *MsgERC20MetadataClaim, opts ...grpc.CallOption) (*MsgERC20MetadataClaimResponse, error) {
+	out := new(MsgERC20MetadataClaimResponse)
+	err := c.cc.Invoke(ctx, "/peggy.v1.Msg/ERC20MetadataClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error) {
 	out := new(MsgSetOrchestratorAddressResponse)
 	err := c.cc.Invoke(ctx, "/peggy.v1.Msg/SetOrchestratorAddress", in, out, opts...)
@@ -1432,6 +1855,24 @@ func (c *msgClient) SetOrchestratorAddress(ctx context.Context, in *MsgSetOrches
 	return out, nil
 }
 
+func (c *msgClient) RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error) {
+	out := new(MsgRotateDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/peggy.v1.Msg/RotateDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepFallbackAccount(ctx context.Context, in *MsgSweepFallbackAccount, opts ...grpc.CallOption) (*MsgSweepFallbackAccountResponse, error) {
+	out := new(MsgSweepFallbackAccountResponse)
+	err := c.cc.Invoke(ctx, "/peggy.v1.Msg/SweepFallbackAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -1443,7 +1884,10 @@ type MsgServer interface {
 	WithdrawClaim(context.Context, *MsgWithdrawClaim) (*MsgWithdrawClaimResponse, error)
 	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
 	LogicCallExecutedClaim(context.Context, *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error)
+	ERC20MetadataClaim(context.Context, *MsgERC20MetadataClaim) (*MsgERC20MetadataClaimResponse, error)
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
+	RotateDelegateKeys(context.Context, *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error)
+	SweepFallbackAccount(context.Context, *MsgSweepFallbackAccount) (*MsgSweepFallbackAccountResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1477,9 +1921,18 @@ func (*UnimplementedMsgServer) ERC20DeployedClaim(ctx context.Context, req *MsgE
 func (*UnimplementedMsgServer) LogicCallExecutedClaim(ctx context.Context, req *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogicCallExecutedClaim not implemented")
 }
+func (*UnimplementedMsgServer) ERC20MetadataClaim(ctx context.Context, req *MsgERC20MetadataClaim) (*MsgERC20MetadataClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20MetadataClaim not implemented")
+}
 func (*UnimplementedMsgServer) SetOrchestratorAddress(ctx context.Context, req *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOrchestratorAddress not implemented")
 }
+func (*UnimplementedMsgServer) RotateDelegateKeys(ctx context.Context, req *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegateKeys not implemented")
+}
+func (*UnimplementedMsgServer) SweepFallbackAccount(ctx context.Context, req *MsgSweepFallbackAccount) (*MsgSweepFallbackAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepFallbackAccount not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1647,6 +2100,24 @@ func _Msg_LogicCallExecutedClaim_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ERC20MetadataClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgERC20MetadataClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ERC20MetadataClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peggy.v1.Msg/ERC20MetadataClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ERC20MetadataClaim(ctx, req.(*MsgERC20MetadataClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetOrchestratorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetOrchestratorAddress)
 	if err := dec(in); err != nil {
@@ -1665,6 +2136,42 @@ func _Msg_SetOrchestratorAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peggy.v1.Msg/RotateDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, req.(*MsgRotateDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepFallbackAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepFallbackAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepFallbackAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/peggy.v1.Msg/SweepFallbackAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepFallbackAccount(ctx, req.(*MsgSweepFallbackAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "peggy.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1705,10 +2212,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LogicCallExecutedClaim",
 			Handler:    _Msg_LogicCallExecutedClaim_Handler,
 		},
+		{
+			MethodName: "ERC20MetadataClaim",
+			Handler:    _Msg_ERC20MetadataClaim_Handler,
+		},
 		{
 			MethodName: "SetOrchestratorAddress",
 			Handler:    _Msg_SetOrchestratorAddress_Handler,
 		},
+		{
+			MethodName: "RotateDelegateKeys",
+			Handler:    _Msg_RotateDelegateKeys_Handler,
+		},
+		{
+			MethodName: "SweepFallbackAccount",
+			Handler:    _Msg_SweepFallbackAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "peggy/v1/msgs.proto",
@@ -1734,6 +2253,13 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -1781,7 +2307,7 @@ func (m *MsgSetOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
+func (m *MsgRotateDelegateKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1791,20 +2317,20 @@ func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1822,15 +2348,17 @@ func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRotateDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1840,20 +2368,25 @@ func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
+func (m *MsgSweepFallbackAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1863,54 +2396,48 @@ func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendToEth) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSweepFallbackAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSweepFallbackAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.EthDest) > 0 {
-		i -= len(m.EthDest)
-		copy(dAtA[i:], m.EthDest)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthDest)))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Receiver)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSweepFallbackAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1920,20 +2447,34 @@ func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSweepFallbackAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSweepFallbackAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1943,34 +2484,46 @@ func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBatchResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1980,7 +2533,152 @@ func (m *MsgRequestBatchResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendToEth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EthDest) > 0 {
+		i -= len(m.EthDest)
+		copy(dAtA[i:], m.EthDest)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthDest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2491,6 +3189,85 @@ func (m *MsgLogicCallExecutedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgERC20MetadataClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgERC20MetadataClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgERC20MetadataClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgERC20MetadataClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgERC20MetadataClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgERC20MetadataClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -2520,6 +3297,10 @@ func (m *MsgSetOrchestratorAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -2532,14 +3313,15 @@ func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgValsetConfirm) Size() (n int) {
+func (m *MsgRotateDelegateKeys) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
 	l = len(m.Orchestrator)
 	if l > 0 {
@@ -2549,69 +3331,148 @@ func (m *MsgValsetConfirm) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Signature)
+	l = len(m.EthSignature)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgValsetConfirmResponse) Size() (n int) {
+func (m *MsgRotateDelegateKeysResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EffectiveHeight))
+	}
 	return n
 }
 
-func (m *MsgSendToEth) Size() (n int) {
+func (m *MsgSweepFallbackAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
+	l = len(m.EthereumSender)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.EthDest)
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Submitter)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
 	return n
 }
 
-func (m *MsgSendToEthResponse) Size() (n int) {
+func (m *MsgSweepFallbackAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgRequestBatch) Size() (n int) {
+func (m *MsgValsetConfirm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
 	l = len(m.Orchestrator)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Denom)
+	l = len(m.EthAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	return n
-}
-
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValsetConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendToEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthDest)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSendToEthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovMsgs(uint64(m.TxId))
+	}
+	return n
+}
+
+func (m *MsgRequestBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgRequestBatchResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2807,60 +3668,584 @@ func (m *MsgERC20DeployedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgERC20DeployedClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLogicCallExecutedClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLogicCallExecutedClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgERC20MetadataClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovMsgs(uint64(m.Decimals))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgERC20MetadataClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgERC20DeployedClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgLogicCallExecutedClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.BlockHeight))
-	}
-	l = len(m.InvalidationId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgRotateDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgLogicCallExecutedClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepFallbackAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2883,15 +4268,15 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepFallbackAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepFallbackAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2919,11 +4304,11 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2951,11 +4336,11 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2983,7 +4368,39 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3009,7 +4426,7 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepFallbackAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3032,12 +4449,46 @@ func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepFallbackAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepFallbackAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3495,6 +4946,25 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5282,6 +6752,259 @@ func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgERC20MetadataClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgERC20MetadataClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgERC20MetadataClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgERC20MetadataClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgERC20MetadataClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgERC20MetadataClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,17 +14,20 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 		ethAddress                   = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
 		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
 		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		ethSignature                 = "e108a7776de6b87183b0690484a74daef44aa6daf907e91abaf7bbfa426ae7706b12e0bd44ef7b0634710d99c2d81087a2f39e075158212343a3b2948ecf33d01c"
 	)
 	specs := map[string]struct {
 		srcCosmosAddr sdk.AccAddress
 		srcValAddr    sdk.ValAddress
 		srcETHAddr    string
+		srcETHSig     string
 		expErr        bool
 	}{
 		"all good": {
 			srcCosmosAddr: cosmosAddress,
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 		},
 		"empty validator address": {
 			srcETHAddr:    ethAddress,
@@ -34,6 +38,7 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 			srcValAddr:    []byte{0x1},
 			srcCosmosAddr: cosmosAddress,
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 			expErr:        true,
 		},
 		"empty cosmos address": {
@@ -45,6 +50,7 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 			srcCosmosAddr: []byte{0x1},
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 			expErr:        true,
 		},
 		"empty eth address": {
@@ -58,10 +64,36 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 			srcETHAddr:    "invalid",
 			expErr:        true,
 		},
+		"lower case eth address": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    strings.ToLower(ethAddress),
+			srcETHSig:     ethSignature,
+		},
+		"eth address with a bad checksum": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    "0xb462864e395d88d6bc7C5dd5F3F5eb4cc2599255",
+			srcETHSig:     ethSignature,
+			expErr:        true,
+		},
+		"empty eth signature": {
+			srcValAddr:    valAddress,
+			srcCosmosAddr: cosmosAddress,
+			srcETHAddr:    ethAddress,
+			expErr:        true,
+		},
+		"invalid eth signature": {
+			srcValAddr:    valAddress,
+			srcCosmosAddr: cosmosAddress,
+			srcETHAddr:    ethAddress,
+			srcETHSig:     "invalid",
+			expErr:        true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
-			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, spec.srcETHAddr)
+			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, spec.srcETHAddr, spec.srcETHSig)
 			// when
 			err := msg.ValidateBasic()
 			if spec.expErr {
@@ -73,3 +105,18 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 	}
 
 }
+
+func TestMsgERC20MetadataClaimHash(t *testing.T) {
+	const tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	claim := func(name, symbol, orchestrator string) *MsgERC20MetadataClaim {
+		return &MsgERC20MetadataClaim{TokenContract: tokenContract, Name: name, Symbol: symbol, Decimals: 18, Orchestrator: orchestrator}
+	}
+	orch1 := sdk.AccAddress(bytes.Repeat([]byte{0x1}, sdk.AddrLen)).String()
+	orch2 := sdk.AccAddress(bytes.Repeat([]byte{0x2}, sdk.AddrLen)).String()
+
+	// the claims of all orchestrators count towards the same attestation
+	assert.Equal(t, claim("Dai", "DAI", orch1).ClaimHash(), claim("Dai", "DAI", orch2).ClaimHash())
+	assert.Equal(t, claim("Dai", "DAI", orch1).ClaimHash(), ERC20MetadataAttestation{TokenContract: tokenContract, Name: "Dai", Symbol: "DAI", Decimals: 18}.ClaimHash())
+	// separators in the fields do not make different metadata collide
+	assert.NotEqual(t, claim("a/b", "c", orch1).ClaimHash(), claim("a", "b/c", orch1).ClaimHash())
+}
@@ -25,6 +25,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransferState is the stage a transfer to Ethereum is in
+type TransferState int32
+
+const (
+	TRANSFER_STATE_UNKNOWN  TransferState = 0
+	TRANSFER_STATE_IN_POOL  TransferState = 1
+	TRANSFER_STATE_IN_BATCH TransferState = 2
+	TRANSFER_STATE_EXECUTED TransferState = 3
+)
+
+var TransferState_name = map[int32]string{
+	0: "TRANSFER_STATE_UNKNOWN",
+	1: "TRANSFER_STATE_IN_POOL",
+	2: "TRANSFER_STATE_IN_BATCH",
+	3: "TRANSFER_STATE_EXECUTED",
+}
+
+var TransferState_value = map[string]int32{
+	"TRANSFER_STATE_UNKNOWN":  0,
+	"TRANSFER_STATE_IN_POOL":  1,
+	"TRANSFER_STATE_IN_BATCH": 2,
+	"TRANSFER_STATE_EXECUTED": 3,
+}
+
+func (x TransferState) String() string {
+	return proto.EnumName(TransferState_name, int32(x))
+}
+
+func (TransferState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_de0a859def4c189a, []int{0}
+}
+
 // OutgoingTx is a withdrawal on the bridged contract
 // TODO: can this type be replaced by outgoing transfer tx
 type OutgoingTx struct {
@@ -185,40 +217,134 @@ func (m *BatchFees) GetToken() string {
 	return ""
 }
 
+// TransferRecord is the outcome of a transfer that left the outgoing pool, it outlives the
+// pool entry. batch_nonce and event_nonce are the batch that executed the transfer and the
+// event its execution was observed at. height is the Cosmos block height the transfer was
+// recorded at, records are pruned transfer_record_retention blocks later
+type TransferRecord struct {
+	TxId       uint64        `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	State      TransferState `protobuf:"varint,2,opt,name=state,proto3,enum=peggy.v1.TransferState" json:"state,omitempty"`
+	BatchNonce uint64        `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	EventNonce uint64        `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Height     uint64        `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
+func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
+func (*TransferRecord) ProtoMessage()    {}
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de0a859def4c189a, []int{3}
+}
+func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecord.Merge(m, src)
+}
+func (m *TransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecord proto.InternalMessageInfo
+
+func (m *TransferRecord) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetState() TransferState {
+	if m != nil {
+		return m.State
+	}
+	return TRANSFER_STATE_UNKNOWN
+}
+
+func (m *TransferRecord) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("peggy.v1.TransferState", TransferState_name, TransferState_value)
 	proto.RegisterType((*OutgoingTx)(nil), "peggy.v1.OutgoingTx")
 	proto.RegisterType((*IDSet)(nil), "peggy.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "peggy.v1.BatchFees")
+	proto.RegisterType((*TransferRecord)(nil), "peggy.v1.TransferRecord")
 }
 
 func init() { proto.RegisterFile("peggy/v1/pool.proto", fileDescriptor_de0a859def4c189a) }
 
 var fileDescriptor_de0a859def4c189a = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x8d, 0x69, 0xb7, 0xda, 0x18, 0x21, 0x21, 0xb3, 0x42, 0xd9, 0x45, 0xca, 0x56, 0x3d, 0xa0,
-	0x5e, 0xd6, 0x26, 0x70, 0xe0, 0x86, 0x44, 0x40, 0x2b, 0x96, 0xcb, 0x4a, 0xa1, 0x27, 0x2e, 0x55,
-	0x12, 0x0f, 0x6e, 0xd4, 0xc6, 0x13, 0xc5, 0x4e, 0x69, 0xff, 0x82, 0xef, 0xe1, 0x0b, 0x7a, 0xec,
-	0x11, 0x71, 0xa8, 0x50, 0xfb, 0x23, 0x28, 0x71, 0x0e, 0x70, 0xdb, 0x93, 0xdf, 0x7b, 0x9e, 0x79,
-	0x7e, 0xe3, 0xa1, 0xcf, 0x2a, 0x50, 0x6a, 0x2b, 0xd6, 0x91, 0xa8, 0x10, 0x57, 0xbc, 0xaa, 0xd1,
-	0x22, 0x3b, 0xef, 0x44, 0xbe, 0x8e, 0xae, 0xc2, 0x1c, 0x4d, 0x89, 0x46, 0x64, 0xa9, 0x01, 0xb1,
-	0x8e, 0x32, 0xb0, 0x69, 0x24, 0x72, 0x2c, 0xb4, 0xab, 0xbc, 0xba, 0x50, 0xa8, 0xb0, 0x83, 0xa2,
-	0x45, 0x4e, 0x9d, 0xfc, 0x24, 0x94, 0xde, 0x37, 0x56, 0x61, 0xa1, 0xd5, 0x6c, 0xc3, 0x9e, 0xd3,
-	0x91, 0x01, 0x2d, 0xa1, 0x0e, 0xc8, 0x98, 0x4c, 0xfd, 0xa4, 0x67, 0xec, 0x05, 0xf5, 0x25, 0x18,
-	0x3b, 0x4f, 0xa5, 0xac, 0x83, 0x47, 0xdd, 0xd5, 0x79, 0x2b, 0xbc, 0x97, 0xb2, 0x66, 0x6f, 0xe9,
-	0x28, 0x2d, 0xb1, 0xd1, 0x36, 0x18, 0x8c, 0xc9, 0xf4, 0xf1, 0xeb, 0x4b, 0xee, 0xa2, 0xf0, 0x36,
-	0x0a, 0xef, 0xa3, 0xf0, 0x0f, 0x58, 0xe8, 0x78, 0xb8, 0x3b, 0x5c, 0x7b, 0x49, 0x5f, 0xce, 0xde,
-	0x51, 0x9a, 0xd5, 0x85, 0x54, 0x30, 0xff, 0x06, 0x10, 0x0c, 0x1f, 0xd6, 0xec, 0xbb, 0x96, 0x5b,
-	0x80, 0xc9, 0x25, 0x3d, 0xbb, 0xfb, 0xf8, 0x05, 0x2c, 0x7b, 0x4a, 0x07, 0x85, 0x34, 0x01, 0x19,
-	0x0f, 0xa6, 0xc3, 0xa4, 0x85, 0x93, 0xef, 0xd4, 0x8f, 0x53, 0x9b, 0x2f, 0x6e, 0x01, 0x0c, 0xbb,
-	0xa0, 0x67, 0x16, 0x97, 0xa0, 0xfb, 0xa1, 0x1c, 0x61, 0x33, 0xfa, 0xc4, 0x62, 0x75, 0xaf, 0xe1,
-	0x53, 0xa3, 0x65, 0x0d, 0xd2, 0xcd, 0x15, 0xf3, 0xf6, 0x95, 0xdf, 0x87, 0xeb, 0x97, 0xaa, 0xb0,
-	0x8b, 0x26, 0xe3, 0x39, 0x96, 0xa2, 0xff, 0x5a, 0x77, 0xdc, 0x18, 0xb9, 0x14, 0x76, 0x5b, 0x81,
-	0xe1, 0x77, 0xda, 0x26, 0xff, 0x9b, 0xc4, 0x9f, 0x77, 0xc7, 0x90, 0xec, 0x8f, 0x21, 0xf9, 0x73,
-	0x0c, 0xc9, 0x8f, 0x53, 0xe8, 0xed, 0x4f, 0xa1, 0xf7, 0xeb, 0x14, 0x7a, 0x5f, 0x5f, 0xfd, 0x63,
-	0x98, 0xae, 0xec, 0x02, 0xd2, 0x1b, 0x0d, 0x56, 0xb8, 0xad, 0x96, 0x28, 0x9b, 0x15, 0x88, 0x4d,
-	0x4f, 0x3b, 0xfb, 0x6c, 0xd4, 0xed, 0xe8, 0xcd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x62,
-	0x33, 0x5c, 0xfa, 0x01, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6a, 0xdb, 0x4e,
+	0x10, 0x97, 0x62, 0xd9, 0xc4, 0x1b, 0x12, 0xcc, 0x26, 0x38, 0x8a, 0x02, 0xb2, 0xf1, 0xe1, 0x8f,
+	0xf9, 0x83, 0xa5, 0x3a, 0x85, 0xb6, 0xa7, 0x82, 0xed, 0xd8, 0xc4, 0x6d, 0x91, 0x8b, 0xac, 0xd0,
+	0xd2, 0x8b, 0x90, 0xb4, 0x13, 0x59, 0xc4, 0xde, 0x35, 0xd2, 0xda, 0x75, 0xde, 0xa0, 0xe4, 0xd4,
+	0x17, 0xc8, 0xa9, 0xf7, 0x3e, 0x40, 0x9f, 0x20, 0xc7, 0x1c, 0x4b, 0x0f, 0xa1, 0xd8, 0x4f, 0xd1,
+	0x5b, 0xd1, 0x87, 0xa1, 0xa1, 0x0e, 0xf4, 0xa4, 0x99, 0xdf, 0x87, 0x66, 0x66, 0x77, 0x07, 0xed,
+	0x4f, 0xc1, 0xf7, 0xaf, 0xf4, 0x79, 0x53, 0x9f, 0x32, 0x36, 0xd6, 0xa6, 0x21, 0xe3, 0x0c, 0x6f,
+	0x27, 0xa0, 0x36, 0x6f, 0x2a, 0xaa, 0xc7, 0xa2, 0x09, 0x8b, 0x74, 0xd7, 0x89, 0x40, 0x9f, 0x37,
+	0x5d, 0xe0, 0x4e, 0x53, 0xf7, 0x58, 0x40, 0x53, 0xa5, 0x72, 0xe0, 0x33, 0x9f, 0x25, 0xa1, 0x1e,
+	0x47, 0x29, 0x5a, 0xfb, 0x26, 0x22, 0x34, 0x98, 0x71, 0x9f, 0x05, 0xd4, 0xb7, 0x16, 0xb8, 0x8c,
+	0x0a, 0x11, 0x50, 0x02, 0xa1, 0x2c, 0x56, 0xc5, 0x7a, 0xd1, 0xcc, 0x32, 0x7c, 0x8c, 0x8a, 0x04,
+	0x22, 0x6e, 0x3b, 0x84, 0x84, 0xf2, 0x56, 0x42, 0x6d, 0xc7, 0x40, 0x8b, 0x90, 0x10, 0x3f, 0x47,
+	0x05, 0x67, 0xc2, 0x66, 0x94, 0xcb, 0xb9, 0xaa, 0x58, 0xdf, 0x39, 0x39, 0xd2, 0xd2, 0x56, 0xb4,
+	0xb8, 0x15, 0x2d, 0x6b, 0x45, 0xeb, 0xb0, 0x80, 0xb6, 0xa5, 0xdb, 0xfb, 0x8a, 0x60, 0x66, 0x72,
+	0xfc, 0x12, 0x21, 0x37, 0x0c, 0x88, 0x0f, 0xf6, 0x05, 0x80, 0x2c, 0xfd, 0x9b, 0xb9, 0x98, 0x5a,
+	0x7a, 0x00, 0xb5, 0x23, 0x94, 0xef, 0x9f, 0x0e, 0x81, 0xe3, 0x12, 0xca, 0x05, 0x24, 0x92, 0xc5,
+	0x6a, 0xae, 0x2e, 0x99, 0x71, 0x58, 0xfb, 0x88, 0x8a, 0x6d, 0x87, 0x7b, 0xa3, 0x1e, 0x40, 0x84,
+	0x0f, 0x50, 0x9e, 0xb3, 0x4b, 0xa0, 0xd9, 0x50, 0x69, 0x82, 0x2d, 0xb4, 0xcb, 0xd9, 0x74, 0x40,
+	0xe1, 0x6c, 0x46, 0x49, 0x08, 0x24, 0x9d, 0xab, 0xad, 0xc5, 0x55, 0x7e, 0xdc, 0x57, 0xfe, 0xf3,
+	0x03, 0x3e, 0x9a, 0xb9, 0x9a, 0xc7, 0x26, 0x7a, 0x76, 0xb4, 0xe9, 0xa7, 0x11, 0x91, 0x4b, 0x9d,
+	0x5f, 0x4d, 0x21, 0xd2, 0xfa, 0x94, 0x9b, 0x0f, 0x7f, 0x52, 0xfb, 0x2a, 0xa2, 0x3d, 0x2b, 0x74,
+	0x68, 0x74, 0x01, 0xa1, 0x09, 0x1e, 0x0b, 0x09, 0xde, 0x47, 0x79, 0xbe, 0xb0, 0x03, 0x92, 0x94,
+	0x97, 0x4c, 0x89, 0x2f, 0xfa, 0x04, 0x37, 0x50, 0x3e, 0xe2, 0x0e, 0x87, 0xa4, 0xea, 0xde, 0xc9,
+	0xa1, 0xb6, 0xbe, 0x48, 0x6d, 0xed, 0x1e, 0xc6, 0xb4, 0x99, 0xaa, 0x70, 0x05, 0xed, 0xb8, 0xf1,
+	0x3c, 0x36, 0x65, 0xd4, 0x83, 0xe4, 0xa0, 0x25, 0x13, 0x25, 0x90, 0x11, 0x23, 0xb1, 0x00, 0xe6,
+	0x40, 0x79, 0x26, 0x90, 0x52, 0x41, 0x02, 0xa5, 0x82, 0x32, 0x2a, 0x8c, 0x20, 0xf0, 0x47, 0x5c,
+	0xce, 0x27, 0x5c, 0x96, 0xfd, 0xff, 0x4b, 0x44, 0xbb, 0x0f, 0x4a, 0xe2, 0x67, 0xa8, 0x6c, 0x99,
+	0x2d, 0x63, 0xd8, 0xeb, 0x9a, 0xf6, 0xd0, 0x6a, 0x59, 0x5d, 0xfb, 0xdc, 0x78, 0x6d, 0x0c, 0xde,
+	0x19, 0x25, 0x41, 0x51, 0xae, 0x6f, 0xaa, 0x8f, 0xb0, 0x1b, 0x7c, 0x7d, 0xc3, 0x7e, 0x3b, 0x18,
+	0xbc, 0x29, 0x89, 0x1b, 0x7d, 0x19, 0x8b, 0x5f, 0xa0, 0xc3, 0xbf, 0x99, 0x76, 0xcb, 0xea, 0x9c,
+	0x95, 0xb6, 0x94, 0xe3, 0xeb, 0x9b, 0xea, 0x63, 0xf4, 0x06, 0x67, 0xf7, 0x7d, 0xb7, 0x73, 0x6e,
+	0x75, 0x4f, 0x4b, 0xb9, 0x8d, 0xce, 0x35, 0xad, 0x48, 0x9f, 0xbe, 0xa8, 0x42, 0xfb, 0xd5, 0xed,
+	0x52, 0x15, 0xef, 0x96, 0xaa, 0xf8, 0x73, 0xa9, 0x8a, 0x9f, 0x57, 0xaa, 0x70, 0xb7, 0x52, 0x85,
+	0xef, 0x2b, 0x55, 0xf8, 0xf0, 0xe4, 0x8f, 0xdb, 0x77, 0xc6, 0x7c, 0x04, 0x4e, 0x83, 0x02, 0xd7,
+	0xd3, 0x15, 0x9c, 0x30, 0x32, 0x1b, 0x83, 0xbe, 0xc8, 0xd2, 0xe4, 0x2d, 0xb8, 0x85, 0x64, 0xa1,
+	0x9e, 0xfe, 0x1e, 0x00, 0x33, 0xf9, 0xe7, 0xbe, 0xa7, 0x03, 0x00, 0x00,
 }
 
 func (m *OutgoingTx) Marshal() (dAtA []byte, err error) {
@@ -359,6 +485,54 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPool(v)
 	base := offset
@@ -422,6 +596,30 @@ func (m *BatchFees) Size() (n int) {
 	return n
 }
 
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovPool(uint64(m.TxId))
+	}
+	if m.State != 0 {
+		n += 1 + sovPool(uint64(m.State))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovPool(uint64(m.BatchNonce))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovPool(uint64(m.EventNonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPool(uint64(m.Height))
+	}
+	return n
+}
+
 func sovPool(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -850,6 +1048,151 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= TransferState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPool(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeLogicCall defines the type for a LogicCallProposal
+	ProposalTypeLogicCall = "PeggyLogicCall"
+	// ProposalTypeERC20Deployment defines the type for a ERC20DeploymentProposal
+	ProposalTypeERC20Deployment = "PeggyERC20Deployment"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &LogicCallProposal{}
+	_ govtypes.Content = &ERC20DeploymentProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeLogicCall)
+	govtypes.RegisterProposalTypeCodec(&LogicCallProposal{}, "peggy/LogicCallProposal")
+	govtypes.RegisterProposalType(ProposalTypeERC20Deployment)
+	govtypes.RegisterProposalTypeCodec(&ERC20DeploymentProposal{}, "peggy/ERC20DeploymentProposal")
+}
+
+// NewLogicCallProposal creates a new logic call proposal
+func NewLogicCallProposal(title, description, logicContract string, payload []byte, transfers, fees sdk.Coins, timeout uint64) *LogicCallProposal {
+	return &LogicCallProposal{
+		Title:                title,
+		Description:          description,
+		LogicContractAddress: logicContract,
+		Payload:              payload,
+		Transfers:            transfers,
+		Fees:                 fees,
+		Timeout:              timeout,
+	}
+}
+
+// GetTitle returns the title of a logic call proposal
+func (p *LogicCallProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a logic call proposal
+func (p *LogicCallProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a logic call proposal
+func (p *LogicCallProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a logic call proposal
+func (p *LogicCallProposal) ProposalType() string { return ProposalTypeLogicCall }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *LogicCallProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(p.LogicContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "logic contract address")
+	}
+	if !p.Transfers.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "transfers")
+	}
+	if !p.Fees.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fees")
+	}
+	if p.Timeout == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p LogicCallProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Logic Call Proposal:
+  Title:          %s
+  Description:    %s
+  Logic Contract: %s
+  Payload:        %s
+  Transfers:      %s
+  Fees:           %s
+  Timeout:        %d
+`, p.Title, p.Description, p.LogicContractAddress, hex.EncodeToString(p.Payload), p.Transfers, p.Fees, p.Timeout))
+	return b.String()
+}
+
+// NewERC20DeploymentProposal creates a new ERC20 deployment proposal
+func NewERC20DeploymentProposal(title, description, denom, name, symbol string, decimals uint64) *ERC20DeploymentProposal {
+	return &ERC20DeploymentProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		Name:        name,
+		Symbol:      symbol,
+		Decimals:    decimals,
+	}
+}
+
+// GetTitle returns the title of an ERC20 deployment proposal
+func (p *ERC20DeploymentProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an ERC20 deployment proposal
+func (p *ERC20DeploymentProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an ERC20 deployment proposal
+func (p *ERC20DeploymentProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ERC20 deployment proposal
+func (p *ERC20DeploymentProposal) ProposalType() string { return ProposalTypeERC20Deployment }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ERC20DeploymentProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "denom")
+	}
+	if _, err := PeggyDenomToERC20(p.Denom); err == nil {
+		return sdkerrors.Wrap(ErrInvalid, "denom is a peggy voucher of an Ethereum originated token")
+	}
+	if strings.TrimSpace(p.Name) == "" {
+		return sdkerrors.Wrap(ErrEmpty, "name")
+	}
+	if strings.TrimSpace(p.Symbol) == "" {
+		return sdkerrors.Wrap(ErrEmpty, "symbol")
+	}
+	// ERC20 decimals are an uint8
+	if p.Decimals > math.MaxUint8 {
+		return sdkerrors.Wrap(ErrInvalid, "decimals")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p ERC20DeploymentProposal) String() string {
+	return fmt.Sprintf(`ERC20 Deployment Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Name:        %s
+  Symbol:      %s
+  Decimals:    %d
+`, p.Title, p.Description, p.Denom, p.Name, p.Symbol, p.Decimals)
+}
@@ -146,6 +146,28 @@ func NewValset(nonce, height uint64, members BridgeValidators) *Valset {
 	return &Valset{Nonce: uint64(nonce), Members: mem, Height: height}
 }
 
+// ValidateBasic performs stateless checks on the members of a valset, members of
+// validators without a registered ethereum address have an empty address
+func (v *Valset) ValidateBasic() error {
+	seen := make(map[string]bool, len(v.Members))
+	for i, m := range v.Members {
+		if m == nil {
+			return sdkerrors.Wrapf(ErrEmpty, "member %d", i)
+		}
+		if m.EthereumAddress == "" {
+			continue
+		}
+		if err := ValidateEthAddress(m.EthereumAddress); err != nil {
+			return sdkerrors.Wrapf(err, "member %d ethereum address", i)
+		}
+		if seen[m.EthereumAddress] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member ethereum address %s", m.EthereumAddress)
+		}
+		seen[m.EthereumAddress] = true
+	}
+	return nil
+}
+
 // GetCheckpoint returns the checkpoint
 func (v Valset) GetCheckpoint(peggyIDstring string) []byte {
 	// TODO replace hardcoded "foo" here with a getter to retrieve the correct PeggyID from the store
@@ -5,7 +5,9 @@ use deep_space::coin::Coin;
 use deep_space::msg::DeepSpaceMsg;
 use ethereum_peggy::utils::downcast_uint256;
 use num256::Uint256;
+use peggy_proto::peggy::DelegateKeysSignMsg;
 use peggy_utils::types::{ERC20DeployedEvent, SendToCosmosEvent, TransactionBatchExecutedEvent};
+use prost::Message;
 /// Any arbitrary message
 #[derive(Serialize, Deserialize, Debug, Clone, Eq, PartialEq)]
 #[serde(tag = "type", content = "value")]
@@ -43,7 +45,9 @@ impl DeepSpaceMsg for PeggyMsg {
 
 /// This message sets both the Cosmos and Ethereum address being delegated for
 /// Orchestrator operations. This allows a validator to use their highly valuable
-/// valoper key to simply sign off on these addresses.
+/// valoper key to simply sign off on these addresses. The delegated Cosmos key
+/// co-signs the transaction and the delegated Ethereum key signs the encoded
+/// DelegateKeysSignMsg, see encode_delegate_keys_sign_msg
 #[derive(Serialize, Deserialize, Debug, Default, Clone, Eq, PartialEq, Hash)]
 pub struct SetOrchestratorAddressMsg {
     #[serde(rename = "eth_address")]
@@ -53,6 +57,42 @@ pub struct SetOrchestratorAddressMsg {
     pub validator: String,
     // the Cosmos address being delegated to
     pub orchestrator: Address,
+    /// a hex encoded string representing the Ethereum signature
+    pub eth_signature: String,
+}
+
+/// Encodes the message the delegated Ethereum key signs to consent to being used by
+/// a validator. The peggy id binds the signature to one bridge and the nonce is the
+/// delegate key nonce of the validator, so a signature can not be replayed
+/// Note: like the confirms this is the message, it is hashed before it is signed
+pub fn encode_delegate_keys_sign_msg(peggy_id: String, validator: String, nonce: u64) -> Vec<u8> {
+    let msg = DelegateKeysSignMsg {
+        peggy_id,
+        validator_address: validator,
+        nonce,
+    };
+    let mut buf = Vec::new();
+    msg.encode(&mut buf)
+        .expect("Failed to encode DelegateKeysSignMsg!");
+    buf
+}
+
+#[test]
+fn test_delegate_keys_sign_msg() {
+    use clarity::utils::hex_str_to_bytes;
+
+    // the protobuf encoding produced by the Cosmos module for the same message
+    let correct_bytes: Vec<u8> = hex_str_to_bytes(
+        "0x0a03666f6f1234636f736d6f7376616c6f70657231717970717870713971637273737a673270767871367273307a716733797963356c7a763778751801",
+    )
+    .unwrap();
+
+    let encoded = encode_delegate_keys_sign_msg(
+        "foo".to_string(),
+        "cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu".to_string(),
+        1,
+    );
+    assert_eq!(correct_bytes, encoded);
 }
 /// a transaction we send to submit a valset confirmation signature
 #[derive(Serialize, Deserialize, Debug, Default, Clone, Eq, PartialEq, Hash)]
@@ -3,11 +3,13 @@ use deep_space::address::Address;
 use peggy_proto::peggy::query_client::QueryClient as PeggyQueryClient;
 use peggy_proto::peggy::QueryBatchConfirmsRequest;
 use peggy_proto::peggy::QueryCurrentValsetRequest;
+use peggy_proto::peggy::QueryDelegateKeyNonceRequest;
 use peggy_proto::peggy::QueryLastEventNonceByAddrRequest;
 use peggy_proto::peggy::QueryLastPendingBatchRequestByAddrRequest;
 use peggy_proto::peggy::QueryLastPendingValsetRequestByAddrRequest;
 use peggy_proto::peggy::QueryLastValsetRequestsRequest;
 use peggy_proto::peggy::QueryOutgoingTxBatchesRequest;
+use peggy_proto::peggy::QueryParamsRequest;
 use peggy_proto::peggy::QueryValsetConfirmsByNonceRequest;
 use peggy_proto::peggy::QueryValsetRequestRequest;
 use peggy_utils::error::PeggyError;
@@ -158,3 +160,28 @@ pub async fn get_last_event_nonce(
         .await?;
     Ok(request.into_inner().event_nonce)
 }
+
+/// Gets the peggy id of the bridge from the module params, it is part of every message
+/// an Ethereum key signs for this bridge
+pub async fn get_peggy_id(client: &mut PeggyQueryClient<Channel>) -> Result<String, PeggyError> {
+    let request = client.params(QueryParamsRequest {}).await?;
+    match request.into_inner().params {
+        Some(params) => Ok(params.peggy_id),
+        None => Err(PeggyError::InvalidBridgeStateError(
+            "Must have peggy params!".to_string(),
+        )),
+    }
+}
+
+/// Gets the nonce the Ethereum key of the next delegate keys of a validator has to sign
+pub async fn get_delegate_key_nonce(
+    client: &mut PeggyQueryClient<Channel>,
+    validator: String,
+) -> Result<u64, PeggyError> {
+    let request = client
+        .delegate_key_nonce(QueryDelegateKeyNonceRequest {
+            validator_address: validator,
+        })
+        .await?;
+    Ok(request.into_inner().nonce)
+}
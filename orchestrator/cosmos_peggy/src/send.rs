@@ -1,28 +1,34 @@
 use crate::messages::*;
+use crate::query::{get_delegate_key_nonce, get_peggy_id};
 use clarity::Address as EthAddress;
 use clarity::PrivateKey as EthPrivateKey;
 use contact::jsonrpc::error::JsonRpcError;
 use contact::types::TXSendResponse;
 use contact::{client::Contact, utils::maybe_get_optional_tx_info};
-use deep_space::address::Address;
 use deep_space::private_key::PrivateKey;
 use deep_space::stdfee::StdFee;
 use deep_space::stdsignmsg::StdSignMsg;
-use deep_space::transaction::TransactionSendType;
+use deep_space::transaction::{Transaction, TransactionSendType};
 use deep_space::{coin::Coin, utils::bytes_to_hex_str};
 use ethereum_peggy::message_signatures::{encode_tx_batch_confirm, encode_valset_confirm};
+use peggy_proto::peggy::query_client::QueryClient as PeggyQueryClient;
+use peggy_utils::error::PeggyError;
 use peggy_utils::types::*;
 use std::collections::HashMap;
+use tonic::transport::Channel;
 
-/// Send a transaction updating the eth address for the sending
-/// Cosmos address. The sending Cosmos address should be a validator
+/// Send a transaction setting the delegate Ethereum and Cosmos keys of the validator
+/// the sending Cosmos key belongs to. The delegate Ethereum key signs the peggy id, the
+/// validator address and the delegate key nonce of the validator. The delegate Cosmos
+/// key co-signs the transaction, so its account has to exist on the chain
 pub async fn update_peggy_delegate_addresses(
     contact: &Contact,
-    delegate_eth_address: EthAddress,
-    delegate_cosmos_address: Address,
+    grpc_client: &mut PeggyQueryClient<Channel>,
+    delegate_eth_key: EthPrivateKey,
+    delegate_cosmos_key: PrivateKey,
     private_key: PrivateKey,
     fee: Coin,
-) -> Result<TXSendResponse, JsonRpcError> {
+) -> Result<TXSendResponse, PeggyError> {
     trace!("Updating Peggy Delegate addresses");
     let our_valoper_address = private_key
         .to_public_key()
@@ -34,33 +40,79 @@ pub async fn update_peggy_delegate_addresses(
         .to_public_key()
         .expect("Invalid private key!")
         .to_address();
+    let delegate_cosmos_address = delegate_cosmos_key
+        .to_public_key()
+        .expect("Invalid private key!")
+        .to_address();
 
-    let tx_info = maybe_get_optional_tx_info(our_address, None, None, None, &contact).await?;
-    trace!("got optional tx info");
-
-    let std_sign_msg = StdSignMsg {
-        chain_id: tx_info.chain_id,
-        account_number: tx_info.account_number,
-        sequence: tx_info.sequence,
-        fee: StdFee {
-            amount: vec![fee],
-            gas: 500_000u64.into(),
-        },
-        msgs: vec![PeggyMsg::SetOrchestratorAddressMsg(
-            SetOrchestratorAddressMsg {
-                eth_address: delegate_eth_address,
-                validator: our_valoper_address,
-                orchestrator: delegate_cosmos_address,
-            },
-        )],
-        memo: String::new(),
+    let peggy_id = get_peggy_id(grpc_client).await?;
+    let nonce = get_delegate_key_nonce(grpc_client, our_valoper_address.clone()).await?;
+    let message = encode_delegate_keys_sign_msg(peggy_id, our_valoper_address.clone(), nonce);
+    let eth_signature = delegate_eth_key.sign_ethereum_msg(&message);
+    trace!("got delegate key nonce {}", nonce);
+
+    let msg = PeggyMsg::SetOrchestratorAddressMsg(SetOrchestratorAddressMsg {
+        eth_address: delegate_eth_key.to_public_key().unwrap(),
+        validator: our_valoper_address,
+        orchestrator: delegate_cosmos_address,
+        eth_signature: bytes_to_hex_str(&eth_signature.to_bytes()),
+    });
+    let fee = StdFee {
+        amount: vec![fee],
+        gas: 500_000u64.into(),
     };
 
-    let tx = private_key
-        .sign_std_msg(std_sign_msg, TransactionSendType::Block)
-        .unwrap();
+    // the signatures follow the order of the signers of the message, the delegate
+    // Cosmos key only signs separately if it is not the validator's own account
+    let mut signers = vec![private_key];
+    if delegate_cosmos_address != our_address {
+        signers.push(delegate_cosmos_key);
+    }
+    let tx = sign_std_msg_by_all(contact, &signers, fee, vec![msg]).await?;
 
-    contact.retry_on_block(tx).await
+    Ok(contact.retry_on_block(tx).await?)
+}
+
+/// Signs messages that need the signatures of several accounts. Every key signs with the
+/// account number and sequence of its own account and the signatures are collected into
+/// one transaction in the order of the keys
+async fn sign_std_msg_by_all(
+    contact: &Contact,
+    private_keys: &[PrivateKey],
+    fee: StdFee,
+    msgs: Vec<PeggyMsg>,
+) -> Result<Transaction<PeggyMsg>, JsonRpcError> {
+    let mut signed = Vec::new();
+    for private_key in private_keys {
+        let address = private_key
+            .to_public_key()
+            .expect("Invalid private key!")
+            .to_address();
+        let tx_info = maybe_get_optional_tx_info(address, None, None, None, contact).await?;
+
+        let std_sign_msg = StdSignMsg {
+            chain_id: tx_info.chain_id,
+            account_number: tx_info.account_number,
+            sequence: tx_info.sequence,
+            fee: fee.clone(),
+            msgs: msgs.clone(),
+            memo: String::new(),
+        };
+        match private_key
+            .sign_std_msg(std_sign_msg, TransactionSendType::Block)
+            .unwrap()
+        {
+            Transaction::Block(tx) => signed.push(tx),
+            _ => unreachable!("Signed for block mode!"),
+        }
+    }
+
+    let mut signed = signed.into_iter();
+    let mut tx = signed.next().expect("No signers!");
+    for other in signed {
+        tx.signatures.extend(other.signatures);
+    }
+    Ok(Transaction::Block(tx))
 }
 
 /// Send in a confirmation for an array of validator sets, it's far more efficient to send these
@@ -10,6 +10,7 @@ use contact::client::Contact;
 use cosmos_peggy::send::update_peggy_delegate_addresses;
 use deep_space::{coin::Coin, mnemonic::Mnemonic, private_key::PrivateKey as CosmosPrivateKey};
 use docopt::Docopt;
+use peggy_proto::peggy::query_client::QueryClient as PeggyQueryClient;
 use rand::{thread_rng, Rng};
 use std::time::Duration;
 use url::Url;
@@ -20,24 +21,28 @@ struct Args {
     flag_cosmos_phrase: Option<String>,
     flag_ethereum_key: Option<String>,
     flag_cosmos_rpc: String,
+    flag_cosmos_grpc: String,
     flag_fees: String,
 }
 
 lazy_static! {
     pub static ref USAGE: String = format!(
-        "Usage: {} --validator-phrase=<key> [--cosmos-phrase=<key>] [--ethereum-key=<key>] --cosmos-rpc=<url> --fees=<denom>
+        "Usage: {} --validator-phrase=<key> [--cosmos-phrase=<key>] [--ethereum-key=<key>] --cosmos-rpc=<url> --cosmos-grpc=<url> --fees=<denom>
         Options:
             -h --help                     Show this screen.
             --validator-phrase=<vkey>    The Cosmos private key of the validator. Must be saved when you generate your key
             --ethereum-key=<ekey>     (Optional) The Ethereum private key to register, will be generated if not provided
-            --cosmos-phrase=<ckey>    (Optional) The phrase for the Cosmos key to register, will be generated if not provided.
+            --cosmos-phrase=<ckey>    (Optional) The phrase for the Cosmos key to register, will be generated and printed if not provided.
+                                      This key co-signs the registration, so its account must hold funds before it can be registered
             --cosmos-rpc=<curl>       The Cosmos Legacy RPC url, usually the validator. This will need to be manually enabled
+            --cosmos-grpc=<gurl>      The Cosmos gRPC url, usually the validator
             --fees=<denom>            The Cosmos Denom in which to pay Cosmos chain fees
         About:
             Special purpose binary for bootstrapping Peggy chains. This will submit and optionally
             generate an Ethereum key that will be used to sign messages on behalf of your Validator
-            on the Cosmos blockchain running the Peggy module. Be aware this Ethereum key must be kept
-            safe as you can be slashed for losing it.
+            on the Cosmos blockchain running the Peggy module. The Ethereum key signs its consent to
+            being used by your Validator and the Cosmos key co-signs the transaction. Be aware this
+            Ethereum key must be kept safe as you can be slashed for losing it.
             Written By: {}
             Version {}",
         env!("CARGO_PKG_NAME"),
@@ -72,7 +77,10 @@ async fn main() {
             new_phrase.as_str(),
             key.to_public_key().unwrap().to_address()
         );
-        key
+        println!(
+            "This key co-signs the registration, send some tokens to it and run again with --cosmos-phrase"
+        );
+        return;
     };
     let ethereum_key = if let Some(key) = args.flag_ethereum_key {
         key.parse().expect("Invalid Ethereum Private key!")
@@ -90,20 +98,24 @@ async fn main() {
     let cosmos_url = Url::parse(&args.flag_cosmos_rpc).expect("Invalid Cosmos RPC url");
     let cosmos_url = cosmos_url.to_string();
     let cosmos_url = cosmos_url.trim_end_matches('/');
+    let _ = Url::parse(&args.flag_cosmos_grpc).expect("Invalid Cosmos gRPC url");
+    let cosmos_grpc_url = args.flag_cosmos_grpc.trim_end_matches('/').to_string();
     let fee_denom = args.flag_fees;
 
     let contact = Contact::new(&cosmos_url, TIMEOUT);
+    let mut grpc_client = PeggyQueryClient::connect(cosmos_grpc_url)
+        .await
+        .expect("Failed to connect to Cosmos gRPC");
     let fee = Coin {
         denom: fee_denom,
         amount: 1u64.into(),
     };
 
-    let ethereum_address = ethereum_key.to_public_key().unwrap();
-    let cosmos_address = cosmos_key.to_public_key().unwrap().to_address();
     update_peggy_delegate_addresses(
         &contact,
-        ethereum_address,
-        cosmos_address,
+        &mut grpc_client,
+        ethereum_key,
+        cosmos_key,
         validator_key,
         fee.clone(),
     )
@@ -6,12 +6,14 @@ use cosmos_peggy::utils::wait_for_next_cosmos_block;
 use deep_space::coin::Coin;
 use deep_space::private_key::PrivateKey as CosmosPrivateKey;
 use futures::future::join_all;
+use peggy_proto::peggy::query_client::QueryClient as PeggyQueryClient;
 use std::process::Command;
 use std::{fs::File, path::Path};
 use std::{
     io::{BufRead, BufReader, Read, Write},
     process::ExitStatus,
 };
+use tonic::transport::Channel;
 
 use crate::COSMOS_NODE_ABCI;
 use crate::ETH_NODE;
@@ -72,6 +74,7 @@ pub fn get_keys() -> Vec<(CosmosPrivateKey, EthPrivateKey)> {
 /// the stdout of this to a file for later test runs to parse
 pub async fn deploy_contracts(
     contact: &Contact,
+    grpc_client: &PeggyQueryClient<Channel>,
     keys: &[(CosmosPrivateKey, EthPrivateKey)],
     fee: Coin,
 ) {
@@ -82,6 +85,8 @@ pub async fn deploy_contracts(
     // Either way, validators need to setup their eth addresses out of band and it's not
     // the orchestrators job. So this isn't exactly where it needs to be in the final version
     // but neither is it really that different.
+    //
+    // The validators delegate to their own Cosmos key, so it does not have to co-sign
     let mut updates = Vec::new();
     for (c_key, e_key) in keys.iter() {
         info!(
@@ -89,13 +94,12 @@ pub async fn deploy_contracts(
             e_key.to_public_key().unwrap(),
             c_key.to_public_key().unwrap().to_address(),
         );
-        updates.push(update_peggy_delegate_addresses(
-            &contact,
-            e_key.to_public_key().unwrap(),
-            c_key.to_public_key().unwrap().to_address(),
-            *c_key,
-            fee.clone(),
-        ));
+        let mut grpc_client = grpc_client.clone();
+        let (c_key, e_key, fee) = (*c_key, *e_key, fee.clone());
+        updates.push(async move {
+            update_peggy_delegate_addresses(contact, &mut grpc_client, e_key, c_key, c_key, fee)
+                .await
+        });
     }
     let update_results = join_all(updates).await;
     for i in update_results {
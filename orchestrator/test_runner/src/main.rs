@@ -103,7 +103,7 @@ pub async fn main() {
     // if we detect this env var we are only deploying contracts, do that then exit.
     if should_deploy_contracts() {
         info!("test-runner in contract deploying mode, deploying contracts, then exiting");
-        deploy_contracts(&contact, &keys, get_fee()).await;
+        deploy_contracts(&contact, &grpc_client, &keys, get_fee()).await;
         return;
     }
 